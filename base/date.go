@@ -18,21 +18,141 @@ package base
 
 import (
 	"encoding"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
+
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
 )
 
 const DateFormat = "2006-01-02"
 
+// Date is an ISO 8601 date or date-time with reduced precision support, e.g. "2021", "2021-05", "2021-05-03" or
+// "2021-05-03T10:00Z". Basic, ordinal and week date representations are accepted when parsing. The qualifiers of the
+// Extended Date/Time Format (EDTF) level 1 ("?", "~" and "%") are supported as well.
+//
+// Dates are marshalled in the ISO 8601 extended calendar representation using the original precision. A Date with
+// UnspecifiedPrecision is marshalled as a full calendar date (see DateFormat). A zero Date is omitted when marshalled
+// as XML attribute.
+//
+// See https://www.loc.gov/standards/datetime/
 type Date struct {
 	time.Time
+
+	// Precision of the date as it was originally expressed.
+	Precision Precision
+
+	// NoZone indicates that a date-time was given without zone designator. The time is then interpreted as UTC, but is
+	// marshalled without zone designator.
+	NoZone bool
+
+	// Uncertain indicates that the date is of questionable reliability (EDTF qualifier "?").
+	Uncertain bool
+
+	// Approximate indicates that the date is an estimate (EDTF qualifier "~").
+	Approximate bool
 }
 
 var (
 	_ encoding.TextMarshaler   = &Date{}
 	_ encoding.TextUnmarshaler = &Date{}
+	_ json.Marshaler           = &Date{}
+	_ json.Unmarshaler         = &Date{}
+	_ xml.MarshalerAttr        = &Date{}
+	_ xml.UnmarshalerAttr      = &Date{}
 )
 
+// ParseDate parses an ISO 8601 or EDTF date or date-time.
+func ParseDate(s string) (Date, error) {
+	var d Date
+
+	date, rest, err := parseISODate(s)
+	if err != nil {
+		return d, fmt.Errorf("ParseDate: invalid date %q", s)
+	}
+
+	var tod isoTime
+	var zone isoZone
+	p := date.precision
+	if len(rest) > 0 && rest[0] == 'T' {
+		if p != DayPrecision {
+			return d, fmt.Errorf("ParseDate: time requires full date in %q", s)
+		}
+		tod, rest, err = parseISOTime(rest[1:])
+		if err != nil {
+			return d, fmt.Errorf("ParseDate: invalid time in %q", s)
+		}
+		p = tod.precision
+
+		i := 0
+		for i < len(rest) && rest[i] != '?' && rest[i] != '~' && rest[i] != '%' {
+			i++
+		}
+		zone, err = parseISOZone(rest[:i])
+		if err != nil {
+			return d, fmt.Errorf("ParseDate: invalid zone designator in %q", s)
+		}
+		rest = rest[i:]
+	}
+
+	switch rest {
+	case "":
+	case "?":
+		d.Uncertain = true
+	case "~":
+		d.Approximate = true
+	case "%":
+		d.Uncertain = true
+		d.Approximate = true
+	default:
+		return d, fmt.Errorf("ParseDate: invalid date %q", s)
+	}
+
+	month, day := max(date.month, 1), max(date.day, 1)
+	d.Time = time.Date(date.year, time.Month(month), day, tod.hour, tod.minute, tod.second, tod.nsec, zone.location())
+	d.Precision = p
+	d.NoZone = p.HasTime() && !zone.given
+	return d, nil
+}
+
+// Bounds returns the half-open range [start, end) of instants covered by d according to its precision, e.g. the date
+// "2021-05" covers [2021-05-01T00:00:00Z, 2021-06-01T00:00:00Z).
+func (d Date) Bounds() (start, end time.Time) {
+	start = truncateToPrecision(d.Time, d.Precision)
+	return start, addPrecision(start, d.Precision)
+}
+
+func (d Date) String() string {
+	return string(d.appendFormat(nil))
+}
+
+func (d Date) appendFormat(b []byte) []byte {
+	p := d.Precision
+	if p == UnspecifiedPrecision {
+		p = DayPrecision
+	}
+
+	b = appendISODate(b, d.Time, p)
+	if p.HasTime() {
+		b = append(b, 'T')
+		b = appendISOTime(b, d.Time, p)
+		if !d.NoZone {
+			b = appendISOZone(b, d.Time)
+		}
+	}
+
+	switch {
+	case d.Uncertain && d.Approximate:
+		b = append(b, '%')
+	case d.Uncertain:
+		b = append(b, '?')
+	case d.Approximate:
+		b = append(b, '~')
+	}
+	return b
+}
+
 func (d Date) MarshalJSON() ([]byte, error) {
 	if y := d.Year(); y < 0 || y >= 10000 {
 		return nil, errors.New("Date.MarshalJSON: year outside of range [0,9999]")
@@ -40,7 +160,7 @@ func (d Date) MarshalJSON() ([]byte, error) {
 
 	b := make([]byte, 0, len(DateFormat)+2)
 	b = append(b, '"')
-	b = d.AppendFormat(b, DateFormat)
+	b = d.appendFormat(b)
 	b = append(b, '"')
 	return b, nil
 }
@@ -50,9 +170,11 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	t, err := time.Parse(`"`+DateFormat+`"`, string(data))
-	*d = Date{Time: t}
-	return err
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
 }
 
 func (d Date) MarshalText() (text []byte, err error) {
@@ -61,11 +183,27 @@ func (d Date) MarshalText() (text []byte, err error) {
 	}
 
 	b := make([]byte, 0, len(DateFormat))
-	return d.AppendFormat(b, DateFormat), nil
+	return d.appendFormat(b), nil
 }
 
 func (d *Date) UnmarshalText(text []byte) error {
-	t, err := time.Parse(DateFormat, string(text))
-	*d = Date{Time: t}
+	d0, err := ParseDate(string(text))
+	*d = d0
 	return err
 }
+
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if d.IsZero() && d.Precision == UnspecifiedPrecision {
+		return xml.Attr{}, nil
+	}
+
+	s, err := d.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(s)}, nil
+}
+
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
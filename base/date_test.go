@@ -0,0 +1,117 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      time.Time
+		precision base.Precision
+		out       string
+	}{
+		{"2021", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), base.YearPrecision, "2021"},
+		{"2021-05", time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC), base.MonthPrecision, "2021-05"},
+		{"2021-05-03", time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC), base.DayPrecision, "2021-05-03"},
+		{"20210503", time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC), base.DayPrecision, "2021-05-03"},
+		{"2021-123", time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC), base.DayPrecision, "2021-05-03"},
+		{"2021-W18-1", time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC), base.DayPrecision, "2021-05-03"},
+		{"2021-05-03T10:00Z", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC), base.MinutePrecision, "2021-05-03T10:00Z"},
+		{"2021-05-03T10:00:00.50+02:00", time.Date(2021, 5, 3, 8, 0, 0, 5e8, time.UTC), base.FractionPrecision(2), "2021-05-03T10:00:00.50+02:00"},
+		{"2021-05-03T10:00:00", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC), base.SecondPrecision, "2021-05-03T10:00:00"},
+		{"2021-05~", time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC), base.MonthPrecision, "2021-05~"},
+		{"2021?", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), base.YearPrecision, "2021?"},
+		{"2021-05-03%", time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC), base.DayPrecision, "2021-05-03%"},
+	}
+
+	for _, tc := range tests {
+		d, err := base.ParseDate(tc.in)
+		if err != nil {
+			t.Errorf("ParseDate(%q): unexpected error: %s", tc.in, err)
+			continue
+		}
+		if !d.Equal(tc.want) || d.Precision != tc.precision {
+			t.Errorf("ParseDate(%q) = %s (%s); want %s (%s)", tc.in, d.Time, d.Precision, tc.want, tc.precision)
+		}
+		if got, _ := d.MarshalText(); string(got) != tc.out {
+			t.Errorf("ParseDate(%q).MarshalText() = %q; want %q", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestParseDateInvalid(t *testing.T) {
+	for _, in := range []string{"", "21", "202105", "2021-13", "2021-02-29", "2021-05-03T", "2021-05T10:00Z", "2021-05-03T25:00Z", "2021-W54-1", "2021-05-03x"} {
+		if _, err := base.ParseDate(in); err == nil {
+			t.Errorf("ParseDate(%q): expected error", in)
+		}
+	}
+}
+
+func TestDateBounds(t *testing.T) {
+	d, _ := base.ParseDate("2021-12")
+	start, end := d.Bounds()
+	if !start.Equal(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected bounds [%s, %s)", start, end)
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	// dates without precision keep the legacy full date representation
+	b, err := json.Marshal(base.Date{Time: time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)})
+	if err != nil || string(b) != `"2021-05-03"` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+
+	var d base.Date
+	if err := json.Unmarshal([]byte(`"2021-05~"`), &d); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	if b, _ = json.Marshal(d); string(b) != `"2021-05~"` {
+		t.Fatalf("unexpected JSON %s", b)
+	}
+}
+
+func TestDateXMLAttr(t *testing.T) {
+	type elem struct {
+		XMLName xml.Name  `xml:"elem"`
+		Date    base.Date `xml:"date,attr,omitempty"`
+	}
+
+	b, err := xml.Marshal(&elem{})
+	if err != nil || string(b) != "<elem></elem>" {
+		t.Errorf("zero attributes: got %q, %v", b, err)
+	}
+
+	d, _ := base.ParseDate("2021-05")
+	b, err = xml.Marshal(&elem{Date: d})
+	if err != nil || string(b) != `<elem date="2021-05"></elem>` {
+		t.Errorf("attributes: got %q, %v", b, err)
+	}
+
+	var got elem
+	if err := xml.Unmarshal(b, &got); err != nil || !got.Date.Equal(d.Time) || got.Date.Precision != d.Precision {
+		t.Errorf("unmarshal: got %+v, %v", got, err)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+// DateTime is a date or date-time according to the W3C Date and Time Formats profile of ISO 8601 (W3CDTF):
+//
+//	YYYY
+//	YYYY-MM
+//	YYYY-MM-DD
+//	YYYY-MM-DDThh:mmTZD
+//	YYYY-MM-DDThh:mm:ssTZD
+//	YYYY-MM-DDThh:mm:ss.sTZD
+//
+// As an extension for xs:dateTime compatibility, the zone designator may be omitted. The precision and the absence of
+// a zone designator are preserved when marshalling. A DateTime with UnspecifiedPrecision is marshalled in
+// time.RFC3339Nano format. A zero DateTime is omitted when marshalled as XML attribute.
+//
+// See http://www.w3.org/TR/NOTE-datetime
+type DateTime struct {
+	time.Time
+
+	// Precision of the date-time as it was originally expressed.
+	Precision Precision
+
+	// NoZone indicates that a date-time was given without zone designator. The time is then interpreted as UTC, but is
+	// marshalled without zone designator.
+	NoZone bool
+}
+
+var (
+	_ encoding.TextMarshaler   = &DateTime{}
+	_ encoding.TextUnmarshaler = &DateTime{}
+	_ json.Marshaler           = &DateTime{}
+	_ json.Unmarshaler         = &DateTime{}
+	_ xml.MarshalerAttr        = &DateTime{}
+	_ xml.UnmarshalerAttr      = &DateTime{}
+)
+
+// ParseDateTime parses a W3CDTF date or date-time.
+func ParseDateTime(s string) (DateTime, error) {
+	date, rest, err := parseISODate(s)
+	// only the extended calendar representation is part of W3CDTF
+	if n := len(s) - len(rest); err != nil || (n != 4 && n != 7 && n != 10) ||
+		(n >= 7 && s[4] != '-') || (n == 10 && s[7] != '-') {
+		return DateTime{}, fmt.Errorf("ParseDateTime: invalid date %q", s)
+	}
+
+	var tod isoTime
+	var zone isoZone
+	p := date.precision
+	if rest != "" {
+		if rest[0] != 'T' || p != DayPrecision {
+			return DateTime{}, fmt.Errorf("ParseDateTime: invalid date-time %q", s)
+		}
+		t := rest[1:]
+		tod, rest, err = parseISOTime(t)
+		if err != nil || tod.precision < MinutePrecision || t[2] != ':' ||
+			(tod.precision >= SecondPrecision && t[5] != ':') {
+			return DateTime{}, fmt.Errorf("ParseDateTime: invalid time in %q", s)
+		}
+		p = tod.precision
+		zone, err = parseISOZone(rest)
+		if err != nil {
+			return DateTime{}, fmt.Errorf("ParseDateTime: invalid zone designator in %q", s)
+		}
+	}
+
+	month, day := max(date.month, 1), max(date.day, 1)
+	return DateTime{
+		Time:      time.Date(date.year, time.Month(month), day, tod.hour, tod.minute, tod.second, tod.nsec, zone.location()),
+		Precision: p,
+		NoZone:    p.HasTime() && !zone.given,
+	}, nil
+}
+
+// Bounds returns the half-open range [start, end) of instants covered by dt according to its precision.
+func (dt DateTime) Bounds() (start, end time.Time) {
+	p := dt.Precision
+	if p == UnspecifiedPrecision {
+		p = FractionPrecision(9)
+	}
+	start = truncateToPrecision(dt.Time, p)
+	return start, addPrecision(start, p)
+}
+
+// ToDate returns dt as Date with the same precision.
+func (dt DateTime) ToDate() Date {
+	return Date{Time: dt.Time, Precision: dt.Precision, NoZone: dt.NoZone}
+}
+
+func (dt DateTime) String() string {
+	return string(dt.appendFormat(nil))
+}
+
+func (dt DateTime) appendFormat(b []byte) []byte {
+	if dt.Precision == UnspecifiedPrecision {
+		return dt.AppendFormat(b, time.RFC3339Nano)
+	}
+
+	b = appendISODate(b, dt.Time, dt.Precision)
+	if dt.Precision.HasTime() {
+		b = append(b, 'T')
+		b = appendISOTime(b, dt.Time, dt.Precision)
+		if !dt.NoZone {
+			b = appendISOZone(b, dt.Time)
+		}
+	}
+	return b
+}
+
+func (dt DateTime) MarshalText() ([]byte, error) {
+	if y := dt.Year(); y < 0 || y >= 10000 {
+		return nil, errors.New("DateTime.MarshalText: year outside of range [0,9999]")
+	}
+	return dt.appendFormat(make([]byte, 0, len(time.RFC3339Nano))), nil
+}
+
+func (dt *DateTime) UnmarshalText(text []byte) error {
+	dt0, err := ParseDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*dt = dt0
+	return nil
+}
+
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	s, err := dt.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+func (dt *DateTime) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return dt.UnmarshalText([]byte(s))
+}
+
+func (dt DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if dt.IsZero() && dt.Precision == UnspecifiedPrecision {
+		return xml.Attr{}, nil
+	}
+
+	s, err := dt.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(s)}, nil
+}
+
+func (dt *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dt.UnmarshalText([]byte(attr.Value))
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+func TestParseDateTime(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      time.Time
+		precision base.Precision
+	}{
+		{"1997", time.Date(1997, 1, 1, 0, 0, 0, 0, time.UTC), base.YearPrecision},
+		{"1997-07", time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC), base.MonthPrecision},
+		{"1997-07-16", time.Date(1997, 7, 16, 0, 0, 0, 0, time.UTC), base.DayPrecision},
+		{"1997-07-16T19:20+01:00", time.Date(1997, 7, 16, 18, 20, 0, 0, time.UTC), base.MinutePrecision},
+		{"1997-07-16T19:20:30+01:00", time.Date(1997, 7, 16, 18, 20, 30, 0, time.UTC), base.SecondPrecision},
+		{"1997-07-16T19:20:30.45Z", time.Date(1997, 7, 16, 19, 20, 30, 45e7, time.UTC), base.FractionPrecision(2)},
+		{"1997-07-16T19:20:30", time.Date(1997, 7, 16, 19, 20, 30, 0, time.UTC), base.SecondPrecision},
+	}
+
+	for _, tc := range tests {
+		dt, err := base.ParseDateTime(tc.in)
+		if err != nil {
+			t.Errorf("ParseDateTime(%q): unexpected error: %s", tc.in, err)
+			continue
+		}
+		if !dt.Equal(tc.want) || dt.Precision != tc.precision {
+			t.Errorf("ParseDateTime(%q) = %s (%s); want %s (%s)", tc.in, dt.Time, dt.Precision, tc.want, tc.precision)
+		}
+		if got := dt.String(); got != tc.in {
+			t.Errorf("ParseDateTime(%q).String() = %q", tc.in, got)
+		}
+	}
+}
+
+func TestParseDateTimeInvalid(t *testing.T) {
+	for _, in := range []string{"", "97", "19970716", "1997-197", "1997-W29-3", "1997-07-16T19", "1997-07-16T1920Z", "1997-07T19:20Z", "1997-07-16 19:20Z"} {
+		if _, err := base.ParseDateTime(in); err == nil {
+			t.Errorf("ParseDateTime(%q): expected error", in)
+		}
+	}
+}
+
+func TestDateTimeCodecs(t *testing.T) {
+	type doc struct {
+		XMLName  xml.Name      `xml:"doc" json:"-"`
+		Created  base.DateTime `xml:"created,attr,omitempty" json:"created"`
+		Modified base.DateTime `xml:"modified,attr,omitempty" json:"modified"`
+	}
+
+	in := `<doc created="2021-05"></doc>`
+	d := doc{}
+	if err := xml.Unmarshal([]byte(in), &d); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	out, err := xml.Marshal(d)
+	if err != nil || string(out) != in {
+		t.Fatalf("unexpected XML %s (%v)", out, err)
+	}
+
+	d.Modified = base.DateTime{Time: time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)}
+	out, err = json.Marshal(d)
+	if err != nil || string(out) != `{"created":"2021-05","modified":"2021-05-03T10:00:00Z"}` {
+		t.Fatalf("unexpected JSON %s (%v)", out, err)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// Deep-copy methods for use by the generated models. time.Time is immutable, so copying by value suffices.
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *Date) DeepCopyInto(out *Date) {
+	*out = *in
+}
+
+// DeepCopy returns a copy of the receiver.
+func (in *Date) DeepCopy() *Date {
+	if in == nil {
+		return nil
+	}
+	out := new(Date)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *DateTime) DeepCopyInto(out *DateTime) {
+	*out = *in
+}
+
+// DeepCopy returns a copy of the receiver.
+func (in *DateTime) DeepCopy() *DateTime {
+	if in == nil {
+		return nil
+	}
+	out := new(DateTime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *Time) DeepCopyInto(out *Time) {
+	*out = *in
+}
+
+// DeepCopy returns a copy of the receiver.
+func (in *Time) DeepCopy() *Time {
+	if in == nil {
+		return nil
+	}
+	out := new(Time)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *Interval) DeepCopyInto(out *Interval) {
+	*out = *in
+	out.Start = in.Start.DeepCopy()
+	out.End = in.End.DeepCopy()
+	if in.Duration != nil {
+		d := *in.Duration
+		out.Duration = &d
+	}
+}
+
+// DeepCopy returns a copy of the receiver.
+func (in *Interval) DeepCopy() *Interval {
+	if in == nil {
+		return nil
+	}
+	out := new(Interval)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,21 +17,35 @@ limitations under the License.
 package base
 
 import (
-	"bytes"
 	"encoding"
 	"encoding/json"
-	"text/template"
-
-	"github.com/senseyeio/duration"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 )
 
-// TODO: re-implement as github.com/senseyeio/duration does not support subseconds, start time, etc.
-
-// See duration.tmpl
-var TemplateISO8601 = template.Must(template.New("duration").Parse(`P{{if .Y}}{{.Y}}Y{{end}}{{if .M}}{{.M}}M{{end}}{{if .W}}{{.W}}W{{end}}{{if .D}}{{.D}}D{{end}}{{if .HasTimePart}}T{{end }}{{if .TH}}{{.TH}}H{{end}}{{if .TM}}{{.TM}}M{{end}}{{if .TS}}{{.TS}}S{{end}}`))
+// durationReference is the reference date used to compare durations with nominal components (years, months, weeks and
+// days). It is the first reference date given by XML Schema for the partial order of xs:duration.
+var durationReference = time.Date(1696, time.September, 1, 0, 0, 0, 0, time.UTC)
 
+// Duration is an ISO 8601 duration (PnYnMnWnDTnHnMnS) with support for fractional seconds and negative durations as
+// defined for xs:duration (e.g. "-PT12.040S").
+//
+// Components are kept as given. Years, months, weeks and days are nominal and their exact length depends on the date
+// the duration is applied to (see Shift and TimeDuration). Durations are marshalled in the canonical xs:duration form,
+// i.e. months are folded into years, weeks into days and hours, minutes and seconds are normalized.
 type Duration struct {
-	duration.Duration
+	Years   int64
+	Months  int64
+	Weeks   int64
+	Days    int64
+	Hours   int64
+	Minutes int64
+	Seconds int64
+
+	// Fractional part of the seconds component. Its sign should match the other components.
+	Nanoseconds int64
 }
 
 var (
@@ -41,26 +55,315 @@ var (
 	_ json.Unmarshaler         = &Duration{}
 )
 
-func (d Duration) MarshalText() ([]byte, error) {
-	if d.IsZero() {
-		return []byte("P0D"), nil
+// ParseDuration parses an ISO 8601 duration with an optional leading minus sign.
+func ParseDuration(s string) (Duration, error) {
+	var d Duration
+	in := s
+
+	neg := len(s) > 0 && s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return d, fmt.Errorf("ParseDuration: invalid duration %q", in)
+	}
+	s = s[1:]
+
+	const designators = "YMWD"
+	const timeDesignators = "HMS"
+	inTime, last, found := false, -1, false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return d, fmt.Errorf("ParseDuration: invalid duration %q", in)
+			}
+			inTime, last = true, -1
+			s = s[1:]
+			continue
+		}
+
+		n := 0
+		for n < len(s) && isDigit(s[n]) {
+			n++
+		}
+		if n == 0 {
+			return d, fmt.Errorf("ParseDuration: invalid duration %q", in)
+		}
+		v, err := strconv.ParseInt(s[:n], 10, 64)
+		if err != nil {
+			return d, fmt.Errorf("ParseDuration: invalid duration %q: %w", in, err)
+		}
+		s = s[n:]
+
+		var nsec int64
+		if len(s) > 0 && (s[0] == '.' || s[0] == ',') {
+			n = 1
+			for n < len(s) && isDigit(s[n]) {
+				n++
+			}
+			frac := s[1:n]
+			s = s[n:]
+			if frac == "" || !inTime || len(s) == 0 || s[0] != 'S' {
+				return d, fmt.Errorf("ParseDuration: fraction only allowed for seconds in %q", in)
+			}
+			if len(frac) > 9 {
+				frac = frac[:9]
+			}
+			nsec, _ = strconv.ParseInt(frac, 10, 64)
+			for i := len(frac); i < 9; i++ {
+				nsec *= 10
+			}
+		}
+
+		if len(s) == 0 {
+			return d, fmt.Errorf("ParseDuration: missing designator in %q", in)
+		}
+		set := designators
+		if inTime {
+			set = timeDesignators
+		}
+		i := 0
+		for i < len(set) && set[i] != s[0] {
+			i++
+		}
+		if i == len(set) || i <= last {
+			return d, fmt.Errorf("ParseDuration: unexpected designator %q in %q", s[0], in)
+		}
+		last, found = i, true
+		s = s[1:]
+
+		switch {
+		case !inTime && i == 0:
+			d.Years = v
+		case !inTime && i == 1:
+			d.Months = v
+		case !inTime && i == 2:
+			d.Weeks = v
+		case !inTime && i == 3:
+			d.Days = v
+		case i == 0:
+			d.Hours = v
+		case i == 1:
+			d.Minutes = v
+		default:
+			d.Seconds = v
+			d.Nanoseconds = nsec
+		}
+	}
+	if !found {
+		return d, fmt.Errorf("ParseDuration: invalid duration %q", in)
+	}
+
+	if neg {
+		d = d.Neg()
+	}
+	return d, nil
+}
+
+// DurationOf returns the Duration equivalent of td expressed in hours, minutes and seconds.
+func DurationOf(td time.Duration) Duration {
+	return Duration{
+		Seconds:     int64(td / time.Second),
+		Nanoseconds: int64(td % time.Second),
+	}.Normalize()
+}
+
+// IsZero reports whether all components of d are zero.
+func (d Duration) IsZero() bool {
+	return d == Duration{}
+}
+
+// HasTimePart reports whether d has hour, minute or second components.
+func (d Duration) HasTimePart() bool {
+	return d.Hours != 0 || d.Minutes != 0 || d.Seconds != 0 || d.Nanoseconds != 0
+}
+
+// Neg returns d with all components negated.
+func (d Duration) Neg() Duration {
+	return Duration{
+		Years:       -d.Years,
+		Months:      -d.Months,
+		Weeks:       -d.Weeks,
+		Days:        -d.Days,
+		Hours:       -d.Hours,
+		Minutes:     -d.Minutes,
+		Seconds:     -d.Seconds,
+		Nanoseconds: -d.Nanoseconds,
+	}
+}
+
+// Add returns the component-wise sum d+o.
+func (d Duration) Add(o Duration) Duration {
+	return Duration{
+		Years:       d.Years + o.Years,
+		Months:      d.Months + o.Months,
+		Weeks:       d.Weeks + o.Weeks,
+		Days:        d.Days + o.Days,
+		Hours:       d.Hours + o.Hours,
+		Minutes:     d.Minutes + o.Minutes,
+		Seconds:     d.Seconds + o.Seconds,
+		Nanoseconds: d.Nanoseconds + o.Nanoseconds,
+	}
+}
+
+// Sub returns the component-wise difference d-o.
+func (d Duration) Sub(o Duration) Duration {
+	return d.Add(o.Neg())
+}
+
+// maxShiftDays bounds the number of days Shift moves a time by. It is far beyond any date of interest but keeps the
+// calendar arithmetic of package time from overflowing.
+const maxShiftDays = 1 << 40
+
+// Shift returns t shifted by d. Years, months, weeks and days are applied in calendar terms (see time.Time.AddDate)
+// before the exact hours, minutes and seconds are added. Shifts by more than about three billion years saturate.
+func (d Duration) Shift(t time.Time) time.Time {
+	months := clampInt64(clampInt64(d.Years, maxShiftDays/366)*12+clampInt64(d.Months, maxShiftDays/31), maxShiftDays/31)
+	days := clampInt64(clampInt64(d.Weeks, maxShiftDays/7)*7+clampInt64(d.Days, maxShiftDays), maxShiftDays)
+	t = t.AddDate(0, int(months), int(days))
+
+	// Days of the time part are exactly 24 hours long and are added in UTC to avoid overflowing time.Duration.
+	days, rem := d.timeParts()
+	loc := t.Location()
+	return t.UTC().AddDate(0, 0, int(clampInt64(days, maxShiftDays))).Add(rem).In(loc)
+}
+
+// TimeDuration returns the exact length of d when applied to ref. The result saturates at the minimum or maximum
+// time.Duration.
+func (d Duration) TimeDuration(ref time.Time) time.Duration {
+	return d.Shift(ref).Sub(ref)
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is shorter, equal or longer than o. Durations with nominal
+// components are compared relative to the reference date 1696-09-01T00:00:00Z, e.g. P1M equals P30D.
+func (d Duration) Compare(o Duration) int {
+	return d.Shift(durationReference).Compare(o.Shift(durationReference))
+}
+
+// Normalize returns the canonical form of d: months are folded into years, weeks into days and the time part is
+// normalized to 0 ≤ minutes, seconds < 60 and 0 ≤ hours < 24 with the excess carried into days. The signs of the
+// year-month and day-time parts are kept separately.
+func (d Duration) Normalize() Duration {
+	const day = 24 * time.Hour
+	months := d.Years*12 + d.Months
+
+	days, rem := d.timeParts()
+	days += d.Weeks*7 + d.Days + int64(rem/day)
+	rem %= day
+	if days > 0 && rem < 0 {
+		days--
+		rem += day
+	} else if days < 0 && rem > 0 {
+		days++
+		rem -= day
 	}
 
-	var s bytes.Buffer
-	err := TemplateISO8601.Execute(&s, d)
+	return Duration{
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        days,
+		Hours:       int64(rem / time.Hour),
+		Minutes:     int64(rem / time.Minute % 60),
+		Seconds:     int64(rem / time.Second % 60),
+		Nanoseconds: int64(rem % time.Second),
+	}
+}
+
+// timeParts splits the hours, minutes, seconds and nanoseconds of d into whole days and a remainder shorter than four
+// days without overflowing for large components.
+func (d Duration) timeParts() (int64, time.Duration) {
+	const day = 24 * time.Hour
+	days := d.Hours/24 + d.Minutes/(24*60) + d.Seconds/(24*60*60) + d.Nanoseconds/int64(day)
+	rem := time.Duration(d.Hours%24)*time.Hour +
+		time.Duration(d.Minutes%(24*60))*time.Minute +
+		time.Duration(d.Seconds%(24*60*60))*time.Second +
+		time.Duration(d.Nanoseconds%int64(day))
+	return days, rem
+}
+
+// clampInt64 limits v to [-limit, limit].
+func clampInt64(v, limit int64) int64 {
+	return max(-limit, min(v, limit))
+}
+
+func (d Duration) String() string {
+	b, err := d.MarshalText()
 	if err != nil {
-		return nil, err
+		return ""
 	}
+	return string(b)
+}
 
-	return s.Bytes(), nil
+func (d Duration) MarshalText() ([]byte, error) {
+	n := d.Normalize()
+	if n.IsZero() {
+		return []byte("PT0S"), nil
+	}
+
+	ym := n.Years != 0 || n.Months != 0
+	neg := n.Years < 0 || n.Months < 0
+	if dt := n.Days != 0 || n.HasTimePart(); dt {
+		dtNeg := n.Days < 0 || n.Hours < 0 || n.Minutes < 0 || n.Seconds < 0 || n.Nanoseconds < 0
+		if ym && neg != dtNeg {
+			return nil, errors.New("Duration.MarshalText: components with mixed signs")
+		}
+		neg = dtNeg
+	}
+	if neg {
+		n = n.Neg()
+	}
+
+	b := make([]byte, 0, 32)
+	if neg {
+		b = append(b, '-')
+	}
+	b = append(b, 'P')
+	if n.Years != 0 {
+		b = strconv.AppendInt(b, n.Years, 10)
+		b = append(b, 'Y')
+	}
+	if n.Months != 0 {
+		b = strconv.AppendInt(b, n.Months, 10)
+		b = append(b, 'M')
+	}
+	if n.Days != 0 {
+		b = strconv.AppendInt(b, n.Days, 10)
+		b = append(b, 'D')
+	}
+	if n.HasTimePart() {
+		b = append(b, 'T')
+	}
+	if n.Hours != 0 {
+		b = strconv.AppendInt(b, n.Hours, 10)
+		b = append(b, 'H')
+	}
+	if n.Minutes != 0 {
+		b = strconv.AppendInt(b, n.Minutes, 10)
+		b = append(b, 'M')
+	}
+	if n.Seconds != 0 || n.Nanoseconds != 0 {
+		b = strconv.AppendInt(b, n.Seconds, 10)
+		if n.Nanoseconds != 0 {
+			f := n.Nanoseconds
+			w := 9
+			for f%10 == 0 {
+				f /= 10
+				w--
+			}
+			b = append(b, '.')
+			b = appendInt(b, int(f), w)
+		}
+		b = append(b, 'S')
+	}
+	return b, nil
 }
 
 func (d *Duration) UnmarshalText(text []byte) error {
-	d0, err := duration.ParseISO8601(string(text))
+	d0, err := ParseDuration(string(text))
 	if err != nil {
 		return err
 	}
-	*d = Duration{d0}
+	*d = d0
 	return nil
 }
 
@@ -69,13 +372,17 @@ func (d Duration) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return json.Marshal(s)
+	return json.Marshal(string(s))
 }
 
 func (d *Duration) UnmarshalJSON(b []byte) error {
-	var s []byte
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
 	if err := json.Unmarshal(b, &s); err != nil {
 		return err
 	}
-	return d.UnmarshalText(s)
+	return d.UnmarshalText([]byte(s))
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want base.Duration
+		out  string
+	}{
+		{"PT12.040S", base.Duration{Seconds: 12, Nanoseconds: 40_000_000}, "PT12.04S"},
+		{"PT1M40,1S", base.Duration{Minutes: 1, Seconds: 40, Nanoseconds: 100_000_000}, "PT1M40.1S"},
+		{"P1Y2M3W4DT5H6M7S", base.Duration{Years: 1, Months: 2, Weeks: 3, Days: 4, Hours: 5, Minutes: 6, Seconds: 7}, "P1Y2M25DT5H6M7S"},
+		{"PT90M", base.Duration{Minutes: 90}, "PT1H30M"},
+		{"P14M", base.Duration{Months: 14}, "P1Y2M"},
+		{"-PT0.5S", base.Duration{Nanoseconds: -500_000_000}, "-PT0.5S"},
+		{"P0D", base.Duration{}, "PT0S"},
+	}
+
+	for _, tc := range tests {
+		d, err := base.ParseDuration(tc.in)
+		if err != nil {
+			t.Errorf("ParseDuration(%q): unexpected error: %s", tc.in, err)
+			continue
+		}
+		if d != tc.want {
+			t.Errorf("ParseDuration(%q) = %+v; want %+v", tc.in, d, tc.want)
+		}
+		if got := d.String(); got != tc.out {
+			t.Errorf("ParseDuration(%q).String() = %q; want %q", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	for _, in := range []string{"", "P", "PT", "1Y", "P1S", "PT1Y", "P1M1Y", "PT1.5M", "P1.5Y", "PT1H1H", "P-1D"} {
+		if _, err := base.ParseDuration(in); err == nil {
+			t.Errorf("ParseDuration(%q): expected error", in)
+		}
+	}
+}
+
+func TestDurationArithmetic(t *testing.T) {
+	ref := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	if got := (base.Duration{Months: 1, Seconds: 1, Nanoseconds: 5}).TimeDuration(ref); got != 29*24*time.Hour+time.Second+5 {
+		t.Errorf("unexpected time duration %s", got)
+	}
+
+	d := base.Duration{Minutes: 1, Nanoseconds: 600_000_000}.Add(base.Duration{Seconds: 59, Nanoseconds: 400_000_000})
+	if got := d.String(); got != "PT2M" {
+		t.Errorf("unexpected sum %s", got)
+	}
+	if got := d.Sub(base.Duration{Minutes: 3}).String(); got != "-PT1M" {
+		t.Errorf("unexpected difference %s", got)
+	}
+	if _, err := (base.Duration{Months: 1, Days: -1}).MarshalText(); err == nil {
+		t.Error("expected error for mixed signs")
+	}
+
+	if c := (base.Duration{Hours: 1}).Compare(base.Duration{Minutes: 60}); c != 0 {
+		t.Errorf("expected PT1H = PT60M, got %d", c)
+	}
+	if c := (base.Duration{Months: 1}).Compare(base.Duration{Days: 31}); c != -1 {
+		t.Errorf("expected P1M < P31D, got %d", c)
+	}
+	if got := base.DurationOf(90*time.Minute + 40*time.Millisecond).String(); got != "PT1H30M0.04S" {
+		t.Errorf("unexpected duration %s", got)
+	}
+}
+
+func TestDurationLarge(t *testing.T) {
+	ref := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	hours := base.Duration{Hours: 3_000_000}
+	if got, want := hours.Shift(ref), ref.AddDate(0, 0, 125_000); !got.Equal(want) {
+		t.Errorf("unexpected shift %s, want %s", got, want)
+	}
+	if got, want := hours.String(), "P125000D"; got != want {
+		t.Errorf("unexpected duration %s, want %s", got, want)
+	}
+	if c := hours.Compare(base.Duration{Hours: 1}); c != 1 {
+		t.Errorf("expected PT3000000H > PT1H, got %d", c)
+	}
+	if c := hours.Neg().Compare(base.Duration{Hours: -1}); c != -1 {
+		t.Errorf("expected -PT3000000H < -PT1H, got %d", c)
+	}
+	if c := hours.Compare(base.Duration{Days: 125_000}); c != 0 {
+		t.Errorf("expected PT3000000H = P125000D, got %d", c)
+	}
+
+	secs := base.Duration{Seconds: 1 << 40, Nanoseconds: 1}
+	if got, want := secs.Shift(ref), time.Unix(ref.Unix()+1<<40, 1).UTC(); !got.Equal(want) {
+		t.Errorf("unexpected shift %s, want %s", got, want)
+	}
+	if c := secs.Compare(base.Duration{Seconds: 1 << 40}); c != 1 {
+		t.Errorf("expected PT%d.000000001S > PT%dS, got %d", int64(1<<40), int64(1<<40), c)
+	}
+
+	for _, d := range []base.Duration{hours, secs, {Hours: math.MaxInt64}, {Years: math.MaxInt64, Seconds: math.MaxInt64}} {
+		if got := d.TimeDuration(ref); got != math.MaxInt64 {
+			t.Errorf("%v: expected maximum duration, got %s", d, got)
+		}
+		if got := d.Neg().TimeDuration(ref); got != math.MinInt64 {
+			t.Errorf("%v: expected minimum duration, got %s", d.Neg(), got)
+		}
+	}
+	if c := (base.Duration{Hours: math.MaxInt64}).Compare(base.Duration{Hours: math.MinInt64 + 1}); c != 1 {
+		t.Errorf("expected maximum hours > minimum hours, got %d", c)
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	var d base.Duration
+	if err := json.Unmarshal([]byte(`"PT12.040S"`), &d); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	b, err := json.Marshal(d)
+	if err != nil || string(b) != `"PT12.04S"` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+}
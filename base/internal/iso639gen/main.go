@@ -0,0 +1,144 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// iso639gen generates the ISO 639 registry of the base package (iso-codes/iso639.tsv) from the ISO 639-2 and ISO 639-3
+// data of the Debian iso-codes project (https://salsa.debian.org/iso-codes-team/iso-codes). The data is read from the
+// given release of the project or from a local directory containing iso_639-2.json and iso_639-3.json, e.g.
+// /usr/share/iso-codes/json on Debian systems.
+//
+// The table lists all ISO 639-3 languages and the ISO 639-2 collective languages ordered by their ISO 639-3 or ISO
+// 639-2/T code.
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// language is an entry of the iso-codes ISO 639-2 or ISO 639-3 data.
+type language struct {
+	Alpha2        string `json:"alpha_2"`
+	Alpha3        string `json:"alpha_3"`
+	Bibliographic string `json:"bibliographic"`
+	Name          string `json:"name"`
+	Scope         string `json:"scope"`
+}
+
+func main() {
+	version := flag.String("version", "4.15.0", "release of iso-codes")
+	source := flag.String("source", "", "local directory with the iso-codes JSON data instead of the release")
+	out := flag.String("out", "base/iso-codes/iso639.tsv", "output file")
+	flag.Parse()
+
+	b, err := generate(*version, *source)
+	if err == nil {
+		err = os.WriteFile(*out, b, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "iso639gen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the registry table for the iso-codes release version read from source.
+func generate(version, source string) ([]byte, error) {
+	part2, err := load(version, source, "iso_639-2.json", "639-2")
+	if err != nil {
+		return nil, err
+	}
+	part3, err := load(version, source, "iso_639-3.json", "639-3")
+	if err != nil {
+		return nil, err
+	}
+
+	byPart2T := make(map[string]language, len(part2))
+	for _, l := range part2 {
+		byPart2T[l.Alpha3] = l
+	}
+
+	var rows [][]string
+	for _, l := range part3 {
+		var part2B, part2T string
+		if l2, ok := byPart2T[l.Alpha3]; ok {
+			part2T, part2B = l2.Alpha3, l2.Alpha3
+			if l2.Bibliographic != "" {
+				part2B = l2.Bibliographic
+			}
+			delete(byPart2T, l.Alpha3)
+		}
+		rows = append(rows, []string{l.Alpha3, part2B, part2T, l.Alpha2, l.Scope, l.Name})
+	}
+	for _, l := range byPart2T {
+		// the range qaa-qtz reserved for local use is handled by LookupISO639
+		if strings.Contains(l.Alpha3, "-") {
+			continue
+		}
+		rows = append(rows, []string{"", l.Alpha3, l.Alpha3, l.Alpha2, "C", l.Name})
+	}
+	code := func(row []string) string { return cmp.Or(row[0], row[2]) }
+	slices.SortFunc(rows, func(a, b []string) int { return strings.Compare(code(a), code(b)) })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# ISO 639 language codes generated from the Debian iso-codes %s data for ISO 639-2 and "+
+		"ISO 639-3.\n", version)
+	buf.WriteString("# Columns: ISO 639-3, ISO 639-2/B, ISO 639-2/T, ISO 639-1, scope (I, M, S or C), reference name\n")
+	for _, row := range rows {
+		buf.WriteString(strings.Join(row, "\t"))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// load reads the languages of the given iso-codes JSON file.
+func load(version, source, file, key string) ([]language, error) {
+	var b []byte
+	var err error
+	if source != "" {
+		b, err = os.ReadFile(filepath.Join(source, file))
+	} else {
+		b, err = fetch("https://salsa.debian.org/iso-codes-team/iso-codes/-/raw/v" + version + "/data/" + file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string][]language
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return data[key], nil
+}
+
+func fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IntervalOpen is the EDTF notation for an open start or end of an interval.
+const IntervalOpen = ".."
+
+// Interval is an ISO 8601 time interval in the start/end ("2020-01-01/2020-12-31"), start/duration ("2020-01-01/P1Y")
+// or duration/end ("P1M/2021-03") form. Either bound may be open, which is expressed as ".." ("2020/..") or by omitting
+// it ("2020/"). Open bounds are always marshalled as "..".
+//
+// The bounds of an interval are inclusive and cover the full precision of the given dates, i.e. "2020/2021" ends at
+// 2022-01-01T00:00:00Z (exclusive).
+type Interval struct {
+	// Start of the interval. nil if the start is open or given by End and Duration.
+	Start *Date
+
+	// End of the interval. nil if the end is open or given by Start and Duration.
+	End *Date
+
+	// Duration of the interval. Either Start or End must be set if a duration is given.
+	Duration *Duration
+}
+
+var (
+	_ encoding.TextMarshaler   = &Interval{}
+	_ encoding.TextUnmarshaler = &Interval{}
+	_ json.Marshaler           = &Interval{}
+	_ json.Unmarshaler         = &Interval{}
+)
+
+// ParseInterval parses an ISO 8601 or EDTF time interval.
+func ParseInterval(s string) (Interval, error) {
+	var i Interval
+
+	from, to, ok := strings.Cut(s, "/")
+	if !ok {
+		return i, fmt.Errorf("ParseInterval: missing '/' in %q", s)
+	}
+
+	var err error
+	switch {
+	case from == "" || from == IntervalOpen:
+	case from[0] == 'P':
+		i.Duration = &Duration{}
+		err = i.Duration.UnmarshalText([]byte(from))
+	default:
+		i.Start = &Date{}
+		err = i.Start.UnmarshalText([]byte(from))
+	}
+	if err != nil {
+		return Interval{}, fmt.Errorf("ParseInterval: invalid start in %q: %w", s, err)
+	}
+
+	switch {
+	case to == "" || to == IntervalOpen:
+	case to[0] == 'P':
+		if i.Duration != nil {
+			return Interval{}, fmt.Errorf("ParseInterval: at most one duration allowed in %q", s)
+		}
+		i.Duration = &Duration{}
+		err = i.Duration.UnmarshalText([]byte(to))
+	default:
+		i.End = &Date{}
+		err = i.End.UnmarshalText([]byte(to))
+	}
+	if err != nil {
+		return Interval{}, fmt.Errorf("ParseInterval: invalid end in %q: %w", s, err)
+	}
+
+	if err = i.validate(); err != nil {
+		return Interval{}, fmt.Errorf("ParseInterval: %w in %q", err, s)
+	}
+	return i, nil
+}
+
+func (i Interval) validate() error {
+	if i.Duration != nil && i.Start == nil && i.End == nil {
+		return errors.New("duration requires start or end")
+	}
+	if i.Duration != nil && i.Start != nil && i.End != nil {
+		return errors.New("either end or duration may be given")
+	}
+	if i.Start == nil && i.End == nil {
+		return errors.New("at least one bound must be given")
+	}
+	start, end := i.Bounds()
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return errors.New("end not after start")
+	}
+	return nil
+}
+
+// IsOpenStart reports whether i has no lower bound.
+func (i Interval) IsOpenStart() bool {
+	return i.Start == nil && (i.Duration == nil || i.End == nil)
+}
+
+// IsOpenEnd reports whether i has no upper bound.
+func (i Interval) IsOpenEnd() bool {
+	return i.End == nil && (i.Duration == nil || i.Start == nil)
+}
+
+// Bounds returns the half-open range [start, end) of instants covered by i. Open bounds are returned as zero time.
+func (i Interval) Bounds() (start, end time.Time) {
+	if i.Start != nil {
+		start, _ = i.Start.Bounds()
+	}
+	if i.End != nil {
+		_, end = i.End.Bounds()
+	}
+
+	if i.Duration != nil {
+		switch {
+		case i.Start != nil && i.End == nil:
+			end = i.Duration.Shift(start)
+		case i.End != nil && i.Start == nil:
+			start = i.Duration.Neg().Shift(end)
+		}
+	}
+
+	return start, end
+}
+
+// Contains reports whether t lies within i.
+func (i Interval) Contains(t time.Time) bool {
+	start, end := i.Bounds()
+	return (i.IsOpenStart() || !t.Before(start)) && (i.IsOpenEnd() || t.Before(end))
+}
+
+// ContainsInterval reports whether o lies completely within i.
+func (i Interval) ContainsInterval(o Interval) bool {
+	start, end := i.Bounds()
+	oStart, oEnd := o.Bounds()
+
+	if !i.IsOpenStart() && (o.IsOpenStart() || oStart.Before(start)) {
+		return false
+	}
+	if !i.IsOpenEnd() && (o.IsOpenEnd() || oEnd.After(end)) {
+		return false
+	}
+	return true
+}
+
+// Overlaps reports whether i and o share at least one instant.
+func (i Interval) Overlaps(o Interval) bool {
+	start, end := i.Bounds()
+	oStart, oEnd := o.Bounds()
+
+	// i starts before o ends and o starts before i ends
+	return (i.IsOpenStart() || o.IsOpenEnd() || start.Before(oEnd)) &&
+		(o.IsOpenStart() || i.IsOpenEnd() || oStart.Before(end))
+}
+
+func (i Interval) String() string {
+	b, err := i.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func (i Interval) MarshalText() ([]byte, error) {
+	if err := i.validate(); err != nil {
+		return nil, fmt.Errorf("Interval.MarshalText: %w", err)
+	}
+
+	var b []byte
+	switch {
+	case i.Start != nil:
+		s, err := i.Start.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	case i.Duration != nil:
+		s, err := i.Duration.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	default:
+		b = append(b, IntervalOpen...)
+	}
+
+	b = append(b, '/')
+
+	switch {
+	case i.End != nil:
+		s, err := i.End.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	case i.Duration != nil && i.Start != nil:
+		s, err := i.Duration.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	default:
+		b = append(b, IntervalOpen...)
+	}
+
+	return b, nil
+}
+
+func (i *Interval) UnmarshalText(text []byte) error {
+	i0, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*i = i0
+	return nil
+}
+
+func (i Interval) MarshalJSON() ([]byte, error) {
+	s, err := i.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+func (i *Interval) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return i.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in         string
+		start, end time.Time
+		out        string
+	}{
+		{"2020-01-01/2020-12-31", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "2020-01-01/2020-12-31"},
+		{"2020/..", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, "2020/.."},
+		{"../2021-03", time.Time{}, time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), "../2021-03"},
+		{"2020/", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, "2020/.."},
+		{"2020-01-01/P1M", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), "2020-01-01/P1M"},
+		{"P1M/2021-03", time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), "P1M/2021-03"},
+	}
+
+	for _, tc := range tests {
+		i, err := base.ParseInterval(tc.in)
+		if err != nil {
+			t.Errorf("ParseInterval(%q): unexpected error: %s", tc.in, err)
+			continue
+		}
+		start, end := i.Bounds()
+		if !start.Equal(tc.start) || !end.Equal(tc.end) {
+			t.Errorf("ParseInterval(%q).Bounds() = [%s, %s); want [%s, %s)", tc.in, start, end, tc.start, tc.end)
+		}
+		if got := i.String(); got != tc.out {
+			t.Errorf("ParseInterval(%q).String() = %q; want %q", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestParseIntervalInvalid(t *testing.T) {
+	for _, in := range []string{"2020", "../..", "P1Y/P1M", "P1Y/..", "2021/2020", "2020/x"} {
+		if _, err := base.ParseInterval(in); err == nil {
+			t.Errorf("ParseInterval(%q): expected error", in)
+		}
+	}
+}
+
+func TestIntervalContainsAndOverlaps(t *testing.T) {
+	mustParse := func(s string) base.Interval {
+		i, err := base.ParseInterval(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return i
+	}
+
+	year := mustParse("2020/2020")
+	if !year.Contains(time.Date(2020, 12, 31, 23, 59, 59, 0, time.UTC)) {
+		t.Error("expected 2020/2020 to contain end of year")
+	}
+	if year.Contains(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected 2020/2020 not to contain 2021")
+	}
+	if !mustParse("../2021-03").Contains(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected open start to contain 1900")
+	}
+
+	if !year.Overlaps(mustParse("2020-12/..")) || year.Overlaps(mustParse("2021/..")) {
+		t.Error("unexpected overlap result")
+	}
+	if !mustParse("../2021").Overlaps(mustParse("2019/2020-06")) {
+		t.Error("expected open start to overlap")
+	}
+	if !mustParse("2019/..").ContainsInterval(year) || year.ContainsInterval(mustParse("2020/..")) {
+		t.Error("unexpected containment result")
+	}
+}
+
+func TestIntervalJSON(t *testing.T) {
+	var i base.Interval
+	if err := json.Unmarshal([]byte(`"2020-01-01/2020-12-31"`), &i); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	b, err := json.Marshal(i)
+	if err != nil || string(b) != `"2020-01-01/2020-12-31"` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+}
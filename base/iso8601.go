@@ -0,0 +1,483 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Precision describes the granularity of a date, time or date-time value as it was originally expressed.
+type Precision uint8
+
+const (
+	// The precision is not known. Types fall back to their default representation.
+	UnspecifiedPrecision Precision = iota
+	YearPrecision
+	MonthPrecision
+	DayPrecision
+	HourPrecision
+	MinutePrecision
+	SecondPrecision
+
+	// Precisions above SecondPrecision denote the number of fractional second digits (see FractionPrecision).
+	maxPrecision = SecondPrecision + 9
+)
+
+// FractionPrecision returns the precision of a value with the given number of fractional second digits. digits is
+// clamped to [0,9].
+func FractionPrecision(digits int) Precision {
+	digits = max(0, min(digits, 9))
+	return SecondPrecision + Precision(digits)
+}
+
+// FractionDigits returns the number of fractional second digits of p.
+func (p Precision) FractionDigits() int {
+	if p <= SecondPrecision {
+		return 0
+	}
+	return int(min(p, maxPrecision) - SecondPrecision)
+}
+
+// HasTime reports whether p includes a time of day.
+func (p Precision) HasTime() bool {
+	return p >= HourPrecision
+}
+
+func (p Precision) String() string {
+	switch p {
+	case UnspecifiedPrecision:
+		return "unspecified"
+	case YearPrecision:
+		return "year"
+	case MonthPrecision:
+		return "month"
+	case DayPrecision:
+		return "day"
+	case HourPrecision:
+		return "hour"
+	case MinutePrecision:
+		return "minute"
+	case SecondPrecision:
+		return "second"
+	}
+	return "fraction(" + strconv.Itoa(p.FractionDigits()) + ")"
+}
+
+var errSyntax = errors.New("invalid syntax")
+
+// isoDate is the intermediate representation of a parsed ISO 8601 date.
+type isoDate struct {
+	year, month, day int
+	precision        Precision
+}
+
+// isoTime is the intermediate representation of a parsed ISO 8601 time of day.
+type isoTime struct {
+	hour, minute, second, nsec int
+	precision                  Precision
+}
+
+// isoZone is the intermediate representation of a parsed ISO 8601 zone designator.
+type isoZone struct {
+	offset int // in seconds east of UTC
+	given  bool
+}
+
+func (z isoZone) location() *time.Location {
+	if !z.given || z.offset == 0 {
+		return time.UTC
+	}
+	return time.FixedZone("", z.offset)
+}
+
+// parseISODate parses an ISO 8601 date in calendar (YYYY, YYYY-MM, YYYY-MM-DD, YYYYMMDD), ordinal (YYYY-DDD, YYYYDDD)
+// or week (YYYY-Www-D, YYYYWwwD) representation. It returns the unparsed remainder of s.
+func parseISODate(s string) (isoDate, string, error) {
+	var d isoDate
+
+	y, s, ok := digits(s, 4)
+	if !ok {
+		return d, s, errSyntax
+	}
+	d.year = y
+	d.precision = YearPrecision
+
+	extended := len(s) > 0 && s[0] == '-'
+	if extended {
+		s = s[1:]
+	}
+
+	switch {
+	case len(s) > 0 && s[0] == 'W':
+		// week date
+		w, rest, ok := digits(s[1:], 2)
+		if !ok {
+			return d, s, errSyntax
+		}
+		s = rest
+		if extended {
+			if len(s) == 0 || s[0] != '-' {
+				return d, s, errSyntax
+			}
+			s = s[1:]
+		}
+		wd, rest, ok := digits(s, 1)
+		if !ok || w < 1 || w > isoWeeksInYear(y) || wd < 1 || wd > 7 {
+			return d, s, errSyntax
+		}
+		s = rest
+		t := isoWeekStart(y).AddDate(0, 0, (w-1)*7+wd-1)
+		d.year, d.day = t.Year(), t.Day()
+		d.month = int(t.Month())
+		d.precision = DayPrecision
+		return d, s, nil
+
+	case len(s) >= 3 && isDigits(s[:3]) && (len(s) == 3 || !isDigit(s[3])):
+		// ordinal date
+		if !extended && len(s) != 3 && !isDesignator(s[3]) {
+			return d, s, errSyntax
+		}
+		o, rest, _ := digits(s, 3)
+		if o < 1 || o > daysInYear(y) {
+			return d, s, errSyntax
+		}
+		s = rest
+		t := time.Date(y, time.January, o, 0, 0, 0, 0, time.UTC)
+		d.month, d.day = int(t.Month()), t.Day()
+		d.precision = DayPrecision
+		return d, s, nil
+
+	case extended:
+		m, rest, ok := digits(s, 2)
+		if !ok || m < 1 || m > 12 {
+			return d, s, errSyntax
+		}
+		s = rest
+		d.month = m
+		d.precision = MonthPrecision
+		if len(s) == 0 || s[0] != '-' {
+			return d, s, nil
+		}
+		dd, rest, ok := digits(s[1:], 2)
+		if !ok || dd < 1 || dd > daysIn(time.Month(m), y) {
+			return d, s, errSyntax
+		}
+		d.day = dd
+		d.precision = DayPrecision
+		return d, rest, nil
+
+	case len(s) >= 4 && isDigits(s[:4]):
+		// basic calendar date; YYYYMM is not allowed by ISO 8601
+		m, rest, _ := digits(s, 2)
+		dd, rest, _ := digits(rest, 2)
+		if m < 1 || m > 12 || dd < 1 || dd > daysIn(time.Month(m), y) {
+			return d, s, errSyntax
+		}
+		d.month, d.day = m, dd
+		d.precision = DayPrecision
+		return d, rest, nil
+	}
+
+	return d, s, nil
+}
+
+// parseISOTime parses an ISO 8601 time of day (hh, hh:mm, hh:mm:ss with optional fractional seconds, or the basic
+// equivalents hhmm and hhmmss). It returns the unparsed remainder of s.
+func parseISOTime(s string) (isoTime, string, error) {
+	var t isoTime
+
+	h, s, ok := digits(s, 2)
+	if !ok || h > 24 {
+		return t, s, errSyntax
+	}
+	t.hour = h
+	t.precision = HourPrecision
+
+	extended := len(s) > 0 && s[0] == ':'
+	if extended {
+		s = s[1:]
+	} else if len(s) < 2 || !isDigits(s[:2]) {
+		return t, s, t.validate()
+	}
+
+	m, s, ok := digits(s, 2)
+	if !ok || m > 59 {
+		return t, s, errSyntax
+	}
+	t.minute = m
+	t.precision = MinutePrecision
+
+	if extended {
+		if len(s) == 0 || s[0] != ':' {
+			return t, s, t.validate()
+		}
+		s = s[1:]
+	} else if len(s) < 2 || !isDigits(s[:2]) {
+		return t, s, t.validate()
+	}
+
+	sec, s, ok := digits(s, 2)
+	// leap seconds are accepted syntactically and normalized by time.Date
+	if !ok || sec > 60 {
+		return t, s, errSyntax
+	}
+	t.second = sec
+	t.precision = SecondPrecision
+
+	if len(s) > 0 && (s[0] == '.' || s[0] == ',') {
+		n := 1
+		for n < len(s) && isDigit(s[n]) {
+			n++
+		}
+		if n == 1 {
+			return t, s, errSyntax
+		}
+		frac := s[1:n]
+		s = s[n:]
+		t.precision = FractionPrecision(len(frac))
+		if len(frac) > 9 {
+			frac = frac[:9]
+		}
+		ns, _ := strconv.Atoi(frac)
+		for i := len(frac); i < 9; i++ {
+			ns *= 10
+		}
+		t.nsec = ns
+	}
+
+	return t, s, t.validate()
+}
+
+func (t isoTime) validate() error {
+	if t.hour == 24 && (t.minute != 0 || t.second != 0 || t.nsec != 0) {
+		return errSyntax
+	}
+	return nil
+}
+
+// parseISOZone parses an ISO 8601 zone designator (Z, ±hh, ±hh:mm or ±hhmm). An empty string yields a zone that was
+// not given.
+func parseISOZone(s string) (isoZone, error) {
+	var z isoZone
+	if s == "" {
+		return z, nil
+	}
+	z.given = true
+	if s == "Z" {
+		return z, nil
+	}
+
+	var sign int
+	switch s[0] {
+	case '+':
+		sign = 1
+	case '-':
+		sign = -1
+	default:
+		return z, errSyntax
+	}
+
+	h, s, ok := digits(s[1:], 2)
+	if !ok || h > 23 {
+		return z, errSyntax
+	}
+	if len(s) > 0 && s[0] == ':' {
+		s = s[1:]
+		if s == "" {
+			return z, errSyntax
+		}
+	}
+	var m int
+	if s != "" {
+		if m, s, ok = digits(s, 2); !ok || m > 59 || s != "" {
+			return z, errSyntax
+		}
+	}
+
+	z.offset = sign * (h*60*60 + m*60)
+	return z, nil
+}
+
+// appendISODate appends t formatted as an ISO 8601 extended calendar date truncated to p.
+func appendISODate(b []byte, t time.Time, p Precision) []byte {
+	b = appendInt(b, t.Year(), 4)
+	if p >= MonthPrecision {
+		b = append(b, '-')
+		b = appendInt(b, int(t.Month()), 2)
+	}
+	if p >= DayPrecision {
+		b = append(b, '-')
+		b = appendInt(b, t.Day(), 2)
+	}
+	return b
+}
+
+// appendISOTime appends t formatted as an ISO 8601 extended time of day truncated to p.
+func appendISOTime(b []byte, t time.Time, p Precision) []byte {
+	b = appendInt(b, t.Hour(), 2)
+	if p >= MinutePrecision {
+		b = append(b, ':')
+		b = appendInt(b, t.Minute(), 2)
+	}
+	if p >= SecondPrecision {
+		b = append(b, ':')
+		b = appendInt(b, t.Second(), 2)
+	}
+	if n := p.FractionDigits(); n > 0 {
+		b = append(b, '.')
+		f := t.Nanosecond()
+		for i := n; i < 9; i++ {
+			f /= 10
+		}
+		b = appendInt(b, f, n)
+	}
+	return b
+}
+
+// appendISOZone appends the zone designator of t. A zero offset is written as "Z".
+func appendISOZone(b []byte, t time.Time) []byte {
+	_, off := t.Zone()
+	if off == 0 {
+		return append(b, 'Z')
+	}
+	if off < 0 {
+		b = append(b, '-')
+		off = -off
+	} else {
+		b = append(b, '+')
+	}
+	off /= 60
+	b = appendInt(b, off/60, 2)
+	b = append(b, ':')
+	return appendInt(b, off%60, 2)
+}
+
+// truncateToPrecision returns the start of the period of length p containing t.
+func truncateToPrecision(t time.Time, p Precision) time.Time {
+	y, m, d := t.Date()
+	switch {
+	case p == UnspecifiedPrecision || p >= SecondPrecision:
+		if n := p.FractionDigits(); n < 9 {
+			unit := 1
+			for i := n; i < 9; i++ {
+				unit *= 10
+			}
+			return t.Add(-time.Duration(t.Nanosecond() % unit))
+		}
+		return t
+	case p == YearPrecision:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, t.Location())
+	case p == MonthPrecision:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	case p == DayPrecision:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	case p == HourPrecision:
+		return time.Date(y, m, d, t.Hour(), 0, 0, 0, t.Location())
+	default: // MinutePrecision
+		return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, t.Location())
+	}
+}
+
+// addPrecision returns the start of the period of length p following the one starting at t.
+func addPrecision(t time.Time, p Precision) time.Time {
+	switch p {
+	case YearPrecision:
+		return t.AddDate(1, 0, 0)
+	case MonthPrecision:
+		return t.AddDate(0, 1, 0)
+	case UnspecifiedPrecision, DayPrecision:
+		return t.AddDate(0, 0, 1)
+	case HourPrecision:
+		return t.Add(time.Hour)
+	case MinutePrecision:
+		return t.Add(time.Minute)
+	}
+	unit := time.Second
+	for i := 0; i < p.FractionDigits(); i++ {
+		unit /= 10
+	}
+	return t.Add(unit)
+}
+
+func digits(s string, n int) (int, string, bool) {
+	if len(s) < n || !isDigits(s[:n]) {
+		return 0, s, false
+	}
+	v, _ := strconv.Atoi(s[:n])
+	return v, s[n:], true
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// isDesignator reports whether c may follow a basic format date, i.e. starts a time or qualifier.
+func isDesignator(c byte) bool {
+	return c == 'T' || c == '?' || c == '~' || c == '%' || c == '/'
+}
+
+func appendInt(b []byte, v, width int) []byte {
+	var buf [20]byte
+	i := len(buf)
+	for v >= 10 || width > 1 {
+		i--
+		buf[i] = byte('0' + v%10)
+		v /= 10
+		width--
+	}
+	i--
+	buf[i] = byte('0' + v)
+	return append(b, buf[i:]...)
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysInYear(year int) int {
+	if isLeap(year) {
+		return 366
+	}
+	return 365
+}
+
+func daysIn(m time.Month, year int) int {
+	return time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// isoWeekStart returns the Monday of week 1 of the given ISO week-numbering year.
+func isoWeekStart(year int) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	wd := (int(jan4.Weekday()) + 6) % 7 // Monday = 0
+	return jan4.AddDate(0, 0, -wd)
+}
+
+func isoWeeksInYear(year int) int {
+	_, w := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return w
+}
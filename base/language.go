@@ -0,0 +1,342 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ISO639Scope is the scope of an ISO 639 language code.
+type ISO639Scope string
+
+const (
+	IndividualISO639Scope    = ISO639Scope("I")
+	MacrolanguageISO639Scope = ISO639Scope("M")
+	SpecialISO639Scope       = ISO639Scope("S")
+	CollectiveISO639Scope    = ISO639Scope("C")
+)
+
+// ISO639Language is an entry of the ISO 639 registry.
+type ISO639Language struct {
+	// ISO 639-3 code. Empty for collective languages only listed in ISO 639-2.
+	Part3 string
+
+	// ISO 639-2 bibliographic code. Empty if the language is not listed in ISO 639-2.
+	Part2B string
+
+	// ISO 639-2 terminology code. Empty if the language is not listed in ISO 639-2.
+	Part2T string
+
+	// ISO 639-1 code. Empty if the language has no two-letter code.
+	Part1 string
+
+	Scope ISO639Scope
+
+	// Reference name of the language.
+	Name string
+}
+
+// Shortest returns the shortest code of l, which is the code used as primary language subtag in BCP 47.
+func (l ISO639Language) Shortest() string {
+	switch {
+	case l.Part1 != "":
+		return l.Part1
+	case l.Part3 != "":
+		return l.Part3
+	}
+	return l.Part2T
+}
+
+//go:embed iso-codes/iso639.tsv
+var iso639Table string
+
+// iso639Registry maps all codes of the registry to their entry.
+var iso639Registry = sync.OnceValue(func() map[string]*ISO639Language {
+	reg := make(map[string]*ISO639Language, 10000)
+	for _, line := range strings.Split(iso639Table, "\n") {
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Split(line, "\t")
+		l := &ISO639Language{Part3: f[0], Part2B: f[1], Part2T: f[2], Part1: f[3], Scope: ISO639Scope(f[4]), Name: f[5]}
+		for _, c := range []string{l.Part3, l.Part2T, l.Part1} {
+			if c != "" {
+				reg[c] = l
+			}
+		}
+		// bibliographic codes never take precedence over other codes
+		if _, ok := reg[l.Part2B]; l.Part2B != "" && !ok {
+			reg[l.Part2B] = l
+		}
+	}
+	return reg
+})
+
+// LookupISO639 returns the ISO 639 registry entry for an ISO 639-1, 639-2/B, 639-2/T or 639-3 code. Codes of the range
+// qaa–qtz reserved for local use are returned as special entries.
+func LookupISO639(code string) (ISO639Language, bool) {
+	code = strings.ToLower(code)
+	if l, ok := iso639Registry()[code]; ok {
+		return *l, true
+	}
+	if len(code) == 3 && code >= "qaa" && code <= "qtz" && isAlphaString(code) {
+		return ISO639Language{
+			Part3:  code,
+			Part2B: code,
+			Part2T: code,
+			Scope:  SpecialISO639Scope,
+			Name:   "Reserved for local use",
+		}, true
+	}
+	return ISO639Language{}, false
+}
+
+// LanguageTag is a language tag according to BCP 47 (RFC 5646), e.g. "de", "de-CH", "zh-Hant-TW" or "sgn-ase".
+//
+// LanguageTags are not validated when unmarshalled. Use ParseLanguageTag to validate and normalize language tags from
+// external sources.
+//
+// See https://www.rfc-editor.org/rfc/rfc5646
+type LanguageTag string
+
+// grandfatheredTags are the grandfathered tags of RFC 5646 in their canonical case.
+var grandfatheredTags = func() map[string]LanguageTag {
+	m := make(map[string]LanguageTag)
+	for _, t := range []LanguageTag{
+		// irregular
+		"en-GB-oed", "i-ami", "i-bnn", "i-default", "i-enochian", "i-hak", "i-klingon", "i-lux", "i-mingo",
+		"i-navajo", "i-pwn", "i-tao", "i-tay", "i-tsu", "sgn-BE-FR", "sgn-BE-NL", "sgn-CH-DE",
+		// regular
+		"art-lojban", "cel-gaulish", "no-bok", "no-nyn", "zh-guoyu", "zh-hakka", "zh-min", "zh-min-nan", "zh-xiang",
+	} {
+		m[strings.ToLower(string(t))] = t
+	}
+	return m
+}()
+
+// ParseLanguageTag parses and normalizes a BCP 47 language tag. Subtags are converted to their canonical case ("de-ch"
+// becomes "de-CH") and "_" is accepted as separator. As primary language subtag, all ISO 639-1, 639-2/B, 639-2/T and
+// 639-3 codes are accepted and replaced by the shortest code of the language, e.g. "ger" and "deu" become "de".
+func ParseLanguageTag(s string) (LanguageTag, error) {
+	t, err := parseLanguageTag(strings.ReplaceAll(s, "_", "-"), true)
+	if err != nil {
+		return "", fmt.Errorf("ParseLanguageTag: %w in %q", err, s)
+	}
+	return t, nil
+}
+
+// Validate checks that t is a well-formed BCP 47 language tag with a primary language subtag in the ISO 639 registry.
+// Primary language subtags must be given in their shortest form, e.g. "de" and not "deu".
+func (t LanguageTag) Validate() error {
+	if _, err := parseLanguageTag(string(t), false); err != nil {
+		return fmt.Errorf("LanguageTag.Validate: %w in %q", err, t)
+	}
+	return nil
+}
+
+// Canonical returns t in canonical form (see ParseLanguageTag).
+func (t LanguageTag) Canonical() (LanguageTag, error) {
+	return ParseLanguageTag(string(t))
+}
+
+// Language returns the primary language subtag of t in lower case.
+func (t LanguageTag) Language() string {
+	lang, _, _ := strings.Cut(string(t), "-")
+	return strings.ToLower(lang)
+}
+
+// Script returns the script subtag of t or "" if t has none.
+func (t LanguageTag) Script() string {
+	for i, s := range t.subtags() {
+		if i > 0 && len(s) == 4 && isAlphaString(s) {
+			return titleCase(s)
+		}
+		if i > 0 && !isExtlang(s) {
+			break
+		}
+	}
+	return ""
+}
+
+// Region returns the region subtag of t or "" if t has none.
+func (t LanguageTag) Region() string {
+	for i, s := range t.subtags() {
+		if i == 0 || isExtlang(s) || (len(s) == 4 && isAlphaString(s)) {
+			continue
+		}
+		if (len(s) == 2 && isAlphaString(s)) || (len(s) == 3 && isDigits(s)) {
+			return strings.ToUpper(s)
+		}
+		break
+	}
+	return ""
+}
+
+// ISO639 returns the ISO 639 registry entry of the primary language subtag of t.
+func (t LanguageTag) ISO639() (ISO639Language, bool) {
+	return LookupISO639(t.Language())
+}
+
+func (t LanguageTag) subtags() []string {
+	if _, ok := grandfatheredTags[strings.ToLower(string(t))]; ok || t.Language() == "x" {
+		return nil
+	}
+	return strings.Split(string(t), "-")
+}
+
+func parseLanguageTag(s string, lenient bool) (LanguageTag, error) {
+	if s == "" {
+		return "", errors.New("empty language tag")
+	}
+	if t, ok := grandfatheredTags[strings.ToLower(s)]; ok {
+		return t, nil
+	}
+
+	subtags := strings.Split(s, "-")
+	for i, st := range subtags {
+		if st == "" || len(st) > 8 || !isAlphanumString(st) {
+			return "", fmt.Errorf("invalid subtag %q", st)
+		}
+		subtags[i] = strings.ToLower(st)
+	}
+
+	out := make([]string, 0, len(subtags))
+	i := 0
+
+	// language
+	lang := subtags[0]
+	if lang != "x" {
+		if !isAlphaString(lang) || len(lang) < 2 {
+			return "", fmt.Errorf("invalid language subtag %q", lang)
+		}
+		l, ok := LookupISO639(lang)
+		if !ok || len(lang) > 3 {
+			return "", fmt.Errorf("unknown language subtag %q", lang)
+		}
+		if lenient {
+			lang = l.Shortest()
+		} else if lang != l.Shortest() {
+			return "", fmt.Errorf("language subtag %q should be %q", lang, l.Shortest())
+		}
+		out = append(out, lang)
+		i++
+
+		// extlang
+		if len(lang) <= 3 {
+			for n := 0; n < 3 && i < len(subtags) && isExtlang(subtags[i]); n++ {
+				out = append(out, subtags[i])
+				i++
+			}
+		}
+
+		// script
+		if i < len(subtags) && len(subtags[i]) == 4 && isAlphaString(subtags[i]) {
+			out = append(out, titleCase(subtags[i]))
+			i++
+		}
+
+		// region
+		if i < len(subtags) && ((len(subtags[i]) == 2 && isAlphaString(subtags[i])) ||
+			(len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+			out = append(out, strings.ToUpper(subtags[i]))
+			i++
+		}
+
+		// variants
+		variants := map[string]bool{}
+		for i < len(subtags) && isVariant(subtags[i]) {
+			if variants[subtags[i]] {
+				return "", fmt.Errorf("duplicate variant %q", subtags[i])
+			}
+			variants[subtags[i]] = true
+			out = append(out, subtags[i])
+			i++
+		}
+
+		// extensions
+		singletons := map[string]bool{}
+		for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+			singleton := subtags[i]
+			if singletons[singleton] {
+				return "", fmt.Errorf("duplicate extension %q", singleton)
+			}
+			singletons[singleton] = true
+			out = append(out, singleton)
+			i++
+
+			n := 0
+			for i < len(subtags) && len(subtags[i]) >= 2 {
+				out = append(out, subtags[i])
+				i++
+				n++
+			}
+			if n == 0 {
+				return "", fmt.Errorf("empty extension %q", singleton)
+			}
+		}
+	}
+
+	// private use
+	if i < len(subtags) && subtags[i] == "x" {
+		out = append(out, "x")
+		i++
+		if i == len(subtags) {
+			return "", errors.New("empty private use subtag")
+		}
+		out = append(out, subtags[i:]...)
+		i = len(subtags)
+	}
+
+	if i < len(subtags) {
+		return "", fmt.Errorf("unexpected subtag %q", subtags[i])
+	}
+	return LanguageTag(strings.Join(out, "-")), nil
+}
+
+func isExtlang(s string) bool {
+	return len(s) == 3 && isAlphaString(s)
+}
+
+func isVariant(s string) bool {
+	return (len(s) >= 5 && len(s) <= 8 && isAlphanumString(s)) || (len(s) == 4 && isDigit(s[0]) && isAlphanumString(s))
+}
+
+func isAlphaString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isAlpha(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isAlpha(s[i]) && !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func titleCase(s string) string {
+	return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+func TestParseLanguageTag(t *testing.T) {
+	tests := []struct {
+		in   string
+		want base.LanguageTag
+	}{
+		{"de", "de"},
+		{"DE-ch", "de-CH"},
+		{"de_DE", "de-DE"},
+		{"deu", "de"},
+		{"ger-AT", "de-AT"},
+		{"cmn-hans-cn", "cmn-Hans-CN"},
+		{"zh-yue-HK", "zh-yue-HK"},
+		{"es-419", "es-419"},
+		{"sl-rozaj-biske", "sl-rozaj-biske"},
+		{"de-CH-1901", "de-CH-1901"},
+		{"en-a-bbb-x-a-ccc", "en-a-bbb-x-a-ccc"},
+		{"x-whatever", "x-whatever"},
+		{"qaa-Latn", "qaa-Latn"},
+		{"i-KLINGON", "i-klingon"},
+		{"zh-min-nan", "zh-min-nan"},
+	}
+
+	for _, tc := range tests {
+		got, err := base.ParseLanguageTag(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseLanguageTag(%q) = %q (%v); want %q", tc.in, got, err, tc.want)
+		}
+	}
+
+	for _, in := range []string{"", "d", "xx", "abcd", "de-", "de--CH", "de-1901-1901", "en-a-bbb-a-ccc", "en-a", "x",
+		"de-x", "de-CH-toolongsubtag", "de-ÄT"} {
+		if _, err := base.ParseLanguageTag(in); err == nil {
+			t.Errorf("ParseLanguageTag(%q): expected error", in)
+		}
+	}
+}
+
+func TestLanguageTagValidate(t *testing.T) {
+	for _, tag := range []base.LanguageTag{"de", "de-CH", "cmn", "sh", "sgn-ase", "art-lojban"} {
+		if err := tag.Validate(); err != nil {
+			t.Errorf("LanguageTag(%q).Validate(): unexpected error: %s", tag, err)
+		}
+	}
+	for _, tag := range []base.LanguageTag{"deu", "ger", "de_CH", "de-CH-"} {
+		if err := tag.Validate(); err == nil {
+			t.Errorf("LanguageTag(%q).Validate(): expected error", tag)
+		}
+	}
+}
+
+func TestLanguageTagSubtags(t *testing.T) {
+	tag := base.LanguageTag("zh-yue-hant-hk-x-foo")
+	if tag.Language() != "zh" || tag.Script() != "Hant" || tag.Region() != "HK" {
+		t.Errorf("unexpected subtags %q, %q, %q", tag.Language(), tag.Script(), tag.Region())
+	}
+	if tag := base.LanguageTag("en-US"); tag.Script() != "" || tag.Region() != "US" {
+		t.Errorf("unexpected subtags %q, %q", tag.Script(), tag.Region())
+	}
+}
+
+func TestLookupISO639(t *testing.T) {
+	want := base.ISO639Language{
+		Part3:  "deu",
+		Part2B: "ger",
+		Part2T: "deu",
+		Part1:  "de",
+		Scope:  base.IndividualISO639Scope,
+		Name:   "German",
+	}
+	for _, code := range []string{"de", "ger", "deu", "DEU"} {
+		got, ok := base.LookupISO639(code)
+		if !ok {
+			t.Errorf("LookupISO639(%q): not found", code)
+			continue
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("LookupISO639(%q) mismatch (-want +got):\n%s", code, diff)
+		}
+	}
+
+	if l, ok := base.LookupISO639("afa"); !ok || l.Scope != base.CollectiveISO639Scope || l.Shortest() != "afa" {
+		t.Errorf("unexpected collective language %+v", l)
+	}
+	if l, ok := base.LanguageTag("zh-Hant").ISO639(); !ok || l.Part2B != "chi" || l.Scope != base.MacrolanguageISO639Scope {
+		t.Errorf("unexpected macrolanguage %+v", l)
+	}
+	if _, ok := base.LookupISO639("xx"); ok {
+		t.Error("expected unknown code")
+	}
+}
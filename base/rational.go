@@ -0,0 +1,319 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Rational is an exact rational number, e.g. an edit rate of 30000/1001 or an aspect ratio of 16:9. Rationals returned
+// by functions of this package are reduced and have a positive denominator. A zero denominator is interpreted as 1 so
+// that the zero value is 0.
+//
+// Arithmetic panics if the result does not fit into int64 numerator and denominator. The Checked variants return
+// ErrRationalOverflow instead.
+type Rational struct {
+	Num int64
+	Den int64
+}
+
+var (
+	_ encoding.TextMarshaler   = &Rational{}
+	_ encoding.TextUnmarshaler = &Rational{}
+	_ json.Marshaler           = &Rational{}
+	_ json.Unmarshaler         = &Rational{}
+)
+
+var (
+	// ErrRationalOverflow is returned if the result of rational arithmetic does not fit into int64 numerator and
+	// denominator.
+	ErrRationalOverflow = errors.New("base.Rational: overflow")
+
+	// ErrRationalDivisionByZero is returned if a rational is divided by zero.
+	ErrRationalDivisionByZero = errors.New("base.Rational: division by zero")
+)
+
+var (
+	Rate23_976 = Rational{Num: 24000, Den: 1001}
+	Rate29_97  = Rational{Num: 30000, Den: 1001}
+	Rate59_94  = Rational{Num: 60000, Den: 1001}
+)
+
+// wellKnownRates are the rates RationalFromFloat snaps to.
+var wellKnownRates = []Rational{Rate23_976, Rate29_97, Rate59_94}
+
+// rateSnapTolerance is the maximum absolute difference of a float to a well-known rate to be snapped, e.g. 23.98 is
+// snapped to 24000/1001.
+const rateSnapTolerance = 0.005
+
+// NewRational returns the reduced rational num/den. It panics if den is zero.
+func NewRational(num, den int64) Rational {
+	if den == 0 {
+		panic("base.NewRational: zero denominator")
+	}
+	return Rational{Num: num, Den: den}.Reduce()
+}
+
+// ParseRational parses a rational in the forms "30000/1001", "16:9", "25" or "29.97". The result is reduced.
+func ParseRational(s string) (Rational, error) {
+	if i := strings.IndexAny(s, "/:"); i >= 0 {
+		num, err := strconv.ParseInt(s[:i], 10, 64)
+		if err != nil {
+			return Rational{}, fmt.Errorf("ParseRational: invalid numerator in %q", s)
+		}
+		den, err := strconv.ParseInt(s[i+1:], 10, 64)
+		if err != nil || den == 0 {
+			return Rational{}, fmt.Errorf("ParseRational: invalid denominator in %q", s)
+		}
+		return NewRational(num, den), nil
+	}
+
+	// decimals are parsed exactly
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || strings.ContainsAny(s, "eE") {
+		return Rational{}, fmt.Errorf("ParseRational: invalid rational %q", s)
+	}
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return Rational{}, fmt.Errorf("ParseRational: %q out of range", s)
+	}
+	return Rational{Num: r.Num().Int64(), Den: r.Denom().Int64()}, nil
+}
+
+// RationalFromFloat returns a rational approximating f. Values within 0.005 of the well-known rates 23.976, 29.97 and
+// 59.94 are snapped to 24000/1001, 30000/1001 and 60000/1001. Other values are approximated to a relative error of at
+// most 1e-6 using the smallest denominator possible, e.g. 12.5 is returned as 25/2. Values that cannot be approximated
+// that precisely with an int64 numerator and a denominator of at most 2^31, e.g. 1e-300, are returned as the closest
+// approximation found, i.e. 0 for tiny values.
+func RationalFromFloat(f float64) (Rational, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) || math.Abs(f) >= math.MaxInt64 {
+		return Rational{}, fmt.Errorf("RationalFromFloat: %v out of range", f)
+	}
+
+	for _, r := range wellKnownRates {
+		if math.Abs(f-r.Float64()) < rateSnapTolerance {
+			return r, nil
+		}
+	}
+
+	// continued fraction expansion
+	const maxDen = 1 << 31
+	tolerance := 1e-6 * math.Abs(f)
+	var (
+		h0, h1 int64 = 0, 1
+		k0, k1 int64 = 1, 0
+		x            = f
+	)
+	for {
+		a := math.Floor(x)
+		if a >= math.MaxInt64 || a < math.MinInt64 {
+			// the remainder of the previous convergent is too small to be represented
+			break
+		}
+		h, hok := mulAdd(int64(a), h1, h0)
+		k, kok := mulAdd(int64(a), k1, k0)
+		if !hok || !kok || k > maxDen {
+			break
+		}
+		h0, h1 = h1, h
+		k0, k1 = k1, k
+		if math.Abs(f-float64(h1)/float64(k1)) <= tolerance || x == a {
+			break
+		}
+		x = 1 / (x - a)
+	}
+	return NewRational(h1, k1), nil
+}
+
+// mulAdd returns a*x+y and whether the result did not overflow.
+func mulAdd(a, x, y int64) (int64, bool) {
+	p := a * x
+	if a != 0 && (p/a != x || (a == -1 && x == math.MinInt64)) {
+		return 0, false
+	}
+	s := p + y
+	if (y > 0 && s < p) || (y < 0 && s > p) {
+		return 0, false
+	}
+	return s, true
+}
+
+func (r Rational) den() int64 {
+	if r.Den == 0 {
+		return 1
+	}
+	return r.Den
+}
+
+func (r Rational) rat() *big.Rat {
+	return new(big.Rat).SetFrac64(r.Num, r.den())
+}
+
+func ratToRational(x *big.Rat) Rational {
+	r, err := checkedRatToRational(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return r
+}
+
+func checkedRatToRational(x *big.Rat) (Rational, error) {
+	if !x.Num().IsInt64() || !x.Denom().IsInt64() {
+		return Rational{}, ErrRationalOverflow
+	}
+	return Rational{Num: x.Num().Int64(), Den: x.Denom().Int64()}, nil
+}
+
+// Reduce returns r in lowest terms with a positive denominator.
+func (r Rational) Reduce() Rational {
+	return ratToRational(r.rat())
+}
+
+// IsZero reports whether r equals 0.
+func (r Rational) IsZero() bool {
+	return r.Num == 0
+}
+
+// IsInt reports whether r is an integer.
+func (r Rational) IsInt() bool {
+	return r.Num%r.den() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of r.
+func (r Rational) Sign() int {
+	return r.rat().Sign()
+}
+
+// Neg returns -r.
+func (r Rational) Neg() Rational {
+	return ratToRational(r.rat().Neg(r.rat()))
+}
+
+// Inv returns 1/r. It panics if r is zero.
+func (r Rational) Inv() Rational {
+	if r.IsZero() {
+		panic("base.Rational.Inv: division by zero")
+	}
+	return ratToRational(new(big.Rat).Inv(r.rat()))
+}
+
+// Add returns r+o.
+func (r Rational) Add(o Rational) Rational {
+	return ratToRational(new(big.Rat).Add(r.rat(), o.rat()))
+}
+
+// Sub returns r-o.
+func (r Rational) Sub(o Rational) Rational {
+	return ratToRational(new(big.Rat).Sub(r.rat(), o.rat()))
+}
+
+// Mul returns r*o.
+func (r Rational) Mul(o Rational) Rational {
+	return ratToRational(new(big.Rat).Mul(r.rat(), o.rat()))
+}
+
+// Div returns r/o. It panics if o is zero.
+func (r Rational) Div(o Rational) Rational {
+	if o.IsZero() {
+		panic("base.Rational.Div: division by zero")
+	}
+	return ratToRational(new(big.Rat).Quo(r.rat(), o.rat()))
+}
+
+// CheckedAdd returns r+o. It returns ErrRationalOverflow instead of panicking.
+func (r Rational) CheckedAdd(o Rational) (Rational, error) {
+	return checkedRatToRational(new(big.Rat).Add(r.rat(), o.rat()))
+}
+
+// CheckedSub returns r-o. It returns ErrRationalOverflow instead of panicking.
+func (r Rational) CheckedSub(o Rational) (Rational, error) {
+	return checkedRatToRational(new(big.Rat).Sub(r.rat(), o.rat()))
+}
+
+// CheckedMul returns r*o. It returns ErrRationalOverflow instead of panicking.
+func (r Rational) CheckedMul(o Rational) (Rational, error) {
+	return checkedRatToRational(new(big.Rat).Mul(r.rat(), o.rat()))
+}
+
+// CheckedDiv returns r/o. It returns ErrRationalDivisionByZero or ErrRationalOverflow instead of panicking.
+func (r Rational) CheckedDiv(o Rational) (Rational, error) {
+	if o.IsZero() {
+		return Rational{}, ErrRationalDivisionByZero
+	}
+	return checkedRatToRational(new(big.Rat).Quo(r.rat(), o.rat()))
+}
+
+// Compare returns -1, 0 or +1 depending on whether r is less than, equal to or greater than o.
+func (r Rational) Compare(o Rational) int {
+	return r.rat().Cmp(o.rat())
+}
+
+// Equal reports whether r and o represent the same number, e.g. 2/4 equals 1/2.
+func (r Rational) Equal(o Rational) bool {
+	return r.Compare(o) == 0
+}
+
+// Float64 returns the nearest float64 value of r.
+func (r Rational) Float64() float64 {
+	f, _ := r.rat().Float64()
+	return f
+}
+
+// Ratio returns r in the form "16:9" as used for aspect ratios.
+func (r Rational) Ratio() string {
+	return strconv.FormatInt(r.Num, 10) + ":" + strconv.FormatInt(r.den(), 10)
+}
+
+// String returns r in the form "30000/1001".
+func (r Rational) String() string {
+	return strconv.FormatInt(r.Num, 10) + "/" + strconv.FormatInt(r.den(), 10)
+}
+
+func (r Rational) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rational) UnmarshalText(text []byte) error {
+	r0, err := ParseRational(string(text))
+	if err != nil {
+		return err
+	}
+	*r = r0
+	return nil
+}
+
+func (r Rational) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Rational) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return r.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+func TestParseRational(t *testing.T) {
+	tests := []struct {
+		in   string
+		want base.Rational
+	}{
+		{"30000/1001", base.Rational{Num: 30000, Den: 1001}},
+		{"16:9", base.Rational{Num: 16, Den: 9}},
+		{"1920:1080", base.Rational{Num: 16, Den: 9}},
+		{"25", base.Rational{Num: 25, Den: 1}},
+		{"29.97", base.Rational{Num: 2997, Den: 100}},
+		{"-1/-2", base.Rational{Num: 1, Den: 2}},
+		{"3/-6", base.Rational{Num: -1, Den: 2}},
+	}
+
+	for _, tc := range tests {
+		got, err := base.ParseRational(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseRational(%q) = %s (%v); want %s", tc.in, got, err, tc.want)
+		}
+	}
+
+	for _, in := range []string{"", "1/0", "a/b", "16:", "1e3", "1/2/3"} {
+		if _, err := base.ParseRational(in); err == nil {
+			t.Errorf("ParseRational(%q): expected error", in)
+		}
+	}
+}
+
+func TestRationalFromFloat(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want base.Rational
+	}{
+		{23.976, base.Rate23_976},
+		{23.98, base.Rate23_976},
+		{float64(float32(29.97)), base.Rate29_97},
+		{59.94, base.Rate59_94},
+		{25, base.Rational{Num: 25, Den: 1}},
+		{12.5, base.Rational{Num: 25, Den: 2}},
+		{float64(float32(14.985)), base.Rational{Num: 2997, Den: 200}},
+		{0, base.Rational{Num: 0, Den: 1}},
+		{-0.75, base.Rational{Num: -3, Den: 4}},
+		{math.Ldexp(1, -30), base.Rational{Num: 1, Den: 1 << 30}},
+		{1e-12, base.Rational{Num: 0, Den: 1}},
+		{1e-300, base.Rational{Num: 0, Den: 1}},
+		{-1e-300, base.Rational{Num: 0, Den: 1}},
+		{math.SmallestNonzeroFloat64, base.Rational{Num: 0, Den: 1}},
+	}
+
+	for _, tc := range tests {
+		got, err := base.RationalFromFloat(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("RationalFromFloat(%v) = %s (%v); want %s", tc.in, got, err, tc.want)
+		}
+	}
+}
+
+func TestRationalArithmetic(t *testing.T) {
+	a := base.NewRational(1, 2)
+	b := base.NewRational(1, 3)
+
+	if got := a.Add(b); got != base.NewRational(5, 6) {
+		t.Errorf("Add() = %s", got)
+	}
+	if got := a.Sub(b); got != base.NewRational(1, 6) {
+		t.Errorf("Sub() = %s", got)
+	}
+	if got := a.Mul(b); got != base.NewRational(1, 6) {
+		t.Errorf("Mul() = %s", got)
+	}
+	if got := a.Div(b); got != base.NewRational(3, 2) {
+		t.Errorf("Div() = %s", got)
+	}
+	if got := base.Rate29_97.Inv().Mul(base.NewRational(30000, 1)); got != base.NewRational(1001, 1) {
+		t.Errorf("frame duration times 30000 = %s; want 1001/1", got)
+	}
+	if a.Compare(b) != 1 || b.Compare(a) != -1 || !a.Equal(base.Rational{Num: 2, Den: 4}) {
+		t.Error("unexpected comparison result")
+	}
+	if !(base.Rational{}).IsZero() || (base.Rational{}).String() != "0/1" {
+		t.Error("unexpected zero value")
+	}
+	if got := base.TimecodeRate29_97.Rational(); got != base.Rate29_97 {
+		t.Errorf("TimecodeRate29_97.Rational() = %s", got)
+	}
+}
+
+func TestRationalCheckedArithmetic(t *testing.T) {
+	a := base.NewRational(1, 2)
+	b := base.NewRational(1, 3)
+	maxInt := base.NewRational(math.MaxInt64, 1)
+
+	tests := []struct {
+		name string
+		f    func() (base.Rational, error)
+		want base.Rational
+		err  error
+	}{
+		{"add", func() (base.Rational, error) { return a.CheckedAdd(b) }, base.NewRational(5, 6), nil},
+		{"sub", func() (base.Rational, error) { return a.CheckedSub(b) }, base.NewRational(1, 6), nil},
+		{"mul", func() (base.Rational, error) { return a.CheckedMul(b) }, base.NewRational(1, 6), nil},
+		{"div", func() (base.Rational, error) { return a.CheckedDiv(b) }, base.NewRational(3, 2), nil},
+		{"add overflow", func() (base.Rational, error) { return maxInt.CheckedAdd(maxInt) }, base.Rational{}, base.ErrRationalOverflow},
+		{"sub overflow", func() (base.Rational, error) { return maxInt.Neg().CheckedSub(maxInt) }, base.Rational{}, base.ErrRationalOverflow},
+		{"mul overflow", func() (base.Rational, error) { return maxInt.CheckedMul(base.NewRational(2, 1)) }, base.Rational{},
+			base.ErrRationalOverflow},
+		{"div overflow", func() (base.Rational, error) { return maxInt.CheckedDiv(a) }, base.Rational{}, base.ErrRationalOverflow},
+		{"div by zero", func() (base.Rational, error) { return a.CheckedDiv(base.Rational{}) }, base.Rational{},
+			base.ErrRationalDivisionByZero},
+	}
+	for _, tc := range tests {
+		got, err := tc.f()
+		if got != tc.want || !errors.Is(err, tc.err) {
+			t.Errorf("%s: got %s (%v); want %s (%v)", tc.name, got, err, tc.want, tc.err)
+		}
+	}
+}
+
+func TestRationalJSON(t *testing.T) {
+	var r base.Rational
+	if err := json.Unmarshal([]byte(`"16:9"`), &r); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	if r.Ratio() != "16:9" {
+		t.Errorf("Ratio() = %q", r.Ratio())
+	}
+	b, err := json.Marshal(r)
+	if err != nil || string(b) != `"16/9"` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+}
@@ -18,24 +18,79 @@ package base
 
 import (
 	"encoding"
+	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
 )
 
 const TimeFormat = "15:04:05Z07:00"
 
+// Time is a time of day as defined for xs:time, e.g. "10:00:00", "10:00:00.500Z" or "10:00:00+02:00". The date part of
+// the embedded time.Time is 0000-01-01.
+//
+// Fractional seconds and the absence of a zone designator are preserved when marshalling. A Time with
+// UnspecifiedPrecision is marshalled in TimeFormat. A zero Time is omitted when marshalled as XML attribute.
 type Time struct {
 	time.Time
+
+	// Precision of the time as it was originally expressed.
+	Precision Precision
+
+	// NoZone indicates that the time was given without zone designator. The time is then interpreted as UTC, but is
+	// marshalled without zone designator.
+	NoZone bool
 }
 
 var (
 	_ encoding.TextMarshaler   = &Time{}
 	_ encoding.TextUnmarshaler = &Time{}
+	_ json.Marshaler           = &Time{}
+	_ json.Unmarshaler         = &Time{}
+	_ xml.MarshalerAttr        = &Time{}
+	_ xml.UnmarshalerAttr      = &Time{}
 )
 
+// ParseTime parses an ISO 8601 time of day with optional fractional seconds and zone designator.
+func ParseTime(s string) (Time, error) {
+	tod, rest, err := parseISOTime(s)
+	if err != nil {
+		return Time{}, fmt.Errorf("ParseTime: invalid time %q", s)
+	}
+	zone, err := parseISOZone(rest)
+	if err != nil {
+		return Time{}, fmt.Errorf("ParseTime: invalid zone designator in %q", s)
+	}
+
+	return Time{
+		Time:      time.Date(0, time.January, 1, tod.hour, tod.minute, tod.second, tod.nsec, zone.location()),
+		Precision: tod.precision,
+		NoZone:    !zone.given,
+	}, nil
+}
+
+func (t Time) String() string {
+	return string(t.appendFormat(nil))
+}
+
+func (t Time) appendFormat(b []byte) []byte {
+	p := t.Precision
+	if p == UnspecifiedPrecision {
+		p = SecondPrecision
+	}
+
+	b = appendISOTime(b, t.Time, p)
+	if !t.NoZone {
+		b = appendISOZone(b, t.Time)
+	}
+	return b
+}
+
 func (t Time) MarshalJSON() ([]byte, error) {
 	b := make([]byte, 0, len(TimeFormat)+2)
 	b = append(b, '"')
-	b = t.AppendFormat(b, TimeFormat)
+	b = t.appendFormat(b)
 	b = append(b, '"')
 	return b, nil
 }
@@ -45,18 +100,36 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	t0, err := time.Parse(`"`+TimeFormat+`"`, string(data))
-	*t = Time{Time: t0}
-	return err
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
 }
 
 func (t Time) MarshalText() (text []byte, err error) {
 	b := make([]byte, 0, len(TimeFormat))
-	return t.AppendFormat(b, TimeFormat), nil
+	return t.appendFormat(b), nil
 }
 
 func (t *Time) UnmarshalText(text []byte) error {
-	t0, err := time.Parse(TimeFormat, string(text))
-	*t = Time{Time: t0}
+	t0, err := ParseTime(string(text))
+	*t = t0
 	return err
 }
+
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if t.IsZero() && t.Precision == UnspecifiedPrecision {
+		return xml.Attr{}, nil
+	}
+
+	s, err := t.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(s)}, nil
+}
+
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   time.Time
+		noZone bool
+	}{
+		{"10:00:00Z", time.Date(0, 1, 1, 10, 0, 0, 0, time.UTC), false},
+		{"10:00:00.500Z", time.Date(0, 1, 1, 10, 0, 0, 5e8, time.UTC), false},
+		{"10:00:00", time.Date(0, 1, 1, 10, 0, 0, 0, time.UTC), true},
+		{"10:00:00.123456789-05:30", time.Date(0, 1, 1, 15, 30, 0, 123456789, time.UTC), false},
+		{"23:59:59+01:00", time.Date(0, 1, 1, 22, 59, 59, 0, time.UTC), false},
+	}
+
+	for _, tc := range tests {
+		tm, err := base.ParseTime(tc.in)
+		if err != nil {
+			t.Errorf("ParseTime(%q): unexpected error: %s", tc.in, err)
+			continue
+		}
+		if !tm.Equal(tc.want) || tm.NoZone != tc.noZone {
+			t.Errorf("ParseTime(%q) = %s (no zone: %t); want %s (no zone: %t)", tc.in, tm.Time, tm.NoZone, tc.want, tc.noZone)
+		}
+		// values pass through unchanged
+		if got, _ := tm.MarshalText(); string(got) != tc.in {
+			t.Errorf("ParseTime(%q).MarshalText() = %q", tc.in, got)
+		}
+	}
+}
+
+func TestParseTimeInvalid(t *testing.T) {
+	for _, in := range []string{"", "1", "25:00:00", "10:60:00", "24:00:01", "10:00:00.", "10:00:00+25:00", "10:00:00 Z"} {
+		if _, err := base.ParseTime(in); err == nil {
+			t.Errorf("ParseTime(%q): expected error", in)
+		}
+	}
+}
+
+func TestTimeJSON(t *testing.T) {
+	// times without precision keep the legacy representation
+	b, err := json.Marshal(base.Time{Time: time.Date(2021, 5, 3, 10, 0, 0, 5e8, time.UTC)})
+	if err != nil || string(b) != `"10:00:00Z"` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+
+	var tm base.Time
+	if err := json.Unmarshal([]byte(`"10:00:00.50"`), &tm); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	if b, _ = json.Marshal(tm); string(b) != `"10:00:00.50"` {
+		t.Fatalf("unexpected JSON %s", b)
+	}
+}
+
+func TestTimeXMLAttr(t *testing.T) {
+	type elem struct {
+		XMLName xml.Name  `xml:"elem"`
+		Time    base.Time `xml:"time,attr,omitempty"`
+	}
+
+	b, err := xml.Marshal(&elem{})
+	if err != nil || string(b) != "<elem></elem>" {
+		t.Errorf("zero attribute: got %q, %v", b, err)
+	}
+
+	tm, _ := base.ParseTime("10:00:00.50")
+	b, err = xml.Marshal(&elem{Time: tm})
+	if err != nil || string(b) != `<elem time="10:00:00.50"></elem>` {
+		t.Errorf("attribute: got %q, %v", b, err)
+	}
+
+	var got elem
+	if err := xml.Unmarshal(b, &got); err != nil || !got.Time.Equal(tm.Time) || got.Time.Precision != tm.Precision {
+		t.Errorf("unmarshal: got %+v, %v", got, err)
+	}
+}
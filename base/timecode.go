@@ -0,0 +1,350 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// TimecodeRate is the frame rate a timecode is counted in. The exact frame rate is EditRate * FactorNumerator /
+// FactorDenominator frames per second, e.g. 30 * 1000/1001 for 29.97 fps. Timecodes are always counted in the nominal
+// EditRate.
+type TimecodeRate struct {
+	// Nominal frame rate in frames per second, e.g. 30 for 29.97 fps.
+	EditRate int
+
+	// The numerator of a correcting factor. 0 is interpreted as 1.
+	// +optional
+	FactorNumerator int
+
+	// The denominator of a correcting factor. 0 is interpreted as 1.
+	// +optional
+	FactorDenominator int
+}
+
+var (
+	TimecodeRate24    = TimecodeRate{EditRate: 24}
+	TimecodeRate23_98 = TimecodeRate{EditRate: 24, FactorNumerator: 1000, FactorDenominator: 1001}
+	TimecodeRate25    = TimecodeRate{EditRate: 25}
+	TimecodeRate29_97 = TimecodeRate{EditRate: 30, FactorNumerator: 1000, FactorDenominator: 1001}
+	TimecodeRate30    = TimecodeRate{EditRate: 30}
+	TimecodeRate50    = TimecodeRate{EditRate: 50}
+	TimecodeRate59_94 = TimecodeRate{EditRate: 60, FactorNumerator: 1000, FactorDenominator: 1001}
+	TimecodeRate60    = TimecodeRate{EditRate: 60}
+)
+
+func (r TimecodeRate) factor() (num, den int64) {
+	num, den = int64(r.FactorNumerator), int64(r.FactorDenominator)
+	if num == 0 {
+		num = 1
+	}
+	if den == 0 {
+		den = 1
+	}
+	return num, den
+}
+
+func (r TimecodeRate) validate() error {
+	num, den := r.factor()
+	if r.EditRate <= 0 || num < 0 || den < 0 {
+		return fmt.Errorf("invalid timecode rate %d*%d/%d", r.EditRate, num, den)
+	}
+	return nil
+}
+
+// Rational returns the exact frame rate, e.g. 30000/1001 for TimecodeRate29_97.
+func (r TimecodeRate) Rational() Rational {
+	num, den := r.factor()
+	return NewRational(int64(r.EditRate)*num, den)
+}
+
+// dropFrames returns the number of frame numbers dropped each minute (except every tenth minute) for drop-frame
+// timecodes.
+func (r TimecodeRate) dropFrames() (int64, error) {
+	switch r.EditRate {
+	case 30:
+		return 2, nil
+	case 60:
+		return 4, nil
+	}
+	return 0, fmt.Errorf("drop-frame not defined for edit rate %d", r.EditRate)
+}
+
+// Timecode is a SMPTE ST 12-1 timecode "HH:MM:SS:FF". Drop-frame timecodes use ";" as frame separator
+// ("HH:MM:SS;FF"). On input, "." and "," are accepted as alternative non-drop-frame and drop-frame separators.
+//
+// Conversions from and to frame counts and durations need the TimecodeRate the timecode is counted in. Timecodes wrap
+// around after 24 hours.
+type Timecode struct {
+	Hours   int
+	Minutes int
+	Seconds int
+	Frames  int
+
+	// DropFrame indicates a drop-frame timecode for 29.97 or 59.94 fps.
+	DropFrame bool
+}
+
+var (
+	_ encoding.TextMarshaler   = &Timecode{}
+	_ encoding.TextUnmarshaler = &Timecode{}
+	_ json.Marshaler           = &Timecode{}
+	_ json.Unmarshaler         = &Timecode{}
+)
+
+// ParseTimecode parses a SMPTE timecode. The frames are only checked to be in range when the timecode is converted.
+func ParseTimecode(s string) (Timecode, error) {
+	var tc Timecode
+	if len(s) < 11 || s[2] != ':' || s[5] != ':' || !isDigits(s[:2]) || !isDigits(s[3:5]) || !isDigits(s[6:8]) ||
+		!isDigits(s[9:]) {
+		return tc, fmt.Errorf("ParseTimecode: invalid timecode %q", s)
+	}
+
+	switch s[8] {
+	case ':', '.':
+	case ';', ',':
+		tc.DropFrame = true
+	default:
+		return tc, fmt.Errorf("ParseTimecode: invalid frame separator in %q", s)
+	}
+
+	tc.Hours, _, _ = digits(s[:2], 2)
+	tc.Minutes, _, _ = digits(s[3:5], 2)
+	tc.Seconds, _, _ = digits(s[6:8], 2)
+	f, err := strconv.Atoi(s[9:])
+	if err != nil {
+		return Timecode{}, fmt.Errorf("ParseTimecode: invalid frames in %q", s)
+	}
+	tc.Frames = f
+
+	if tc.Hours > 23 || tc.Minutes > 59 || tc.Seconds > 59 {
+		return Timecode{}, fmt.Errorf("ParseTimecode: timecode %q out of range", s)
+	}
+	return tc, nil
+}
+
+// TimecodeFromFrames returns the timecode of frame number n counted from 00:00:00:00. Negative frame numbers and frame
+// numbers beyond 24 hours wrap around.
+func TimecodeFromFrames(n int64, rate TimecodeRate, dropFrame bool) (Timecode, error) {
+	if err := rate.validate(); err != nil {
+		return Timecode{}, fmt.Errorf("TimecodeFromFrames: %w", err)
+	}
+	fps := int64(rate.EditRate)
+
+	day := fps * 24 * 60 * 60
+	var drop int64
+	if dropFrame {
+		var err error
+		if drop, err = rate.dropFrames(); err != nil {
+			return Timecode{}, fmt.Errorf("TimecodeFromFrames: %w", err)
+		}
+		day -= drop * 24 * 6 * 9
+	}
+	n %= day
+	if n < 0 {
+		n += day
+	}
+
+	if dropFrame {
+		perMinute := fps*60 - drop
+		per10Minutes := fps*60*10 - drop*9
+		d, m := n/per10Minutes, n%per10Minutes
+		n += drop * 9 * d
+		if m > drop {
+			n += drop * ((m - drop) / perMinute)
+		}
+	}
+
+	return Timecode{
+		Hours:     int(n / (fps * 60 * 60)),
+		Minutes:   int(n / (fps * 60) % 60),
+		Seconds:   int(n / fps % 60),
+		Frames:    int(n % fps),
+		DropFrame: dropFrame,
+	}, nil
+}
+
+// TimecodeFromDuration returns the timecode of the frame displayed at offset d from 00:00:00:00.
+func TimecodeFromDuration(d time.Duration, rate TimecodeRate, dropFrame bool) (Timecode, error) {
+	if err := rate.validate(); err != nil {
+		return Timecode{}, fmt.Errorf("TimecodeFromDuration: %w", err)
+	}
+	num, den := rate.factor()
+
+	// frames = floor(d * editRate * num / (den * 1e9))
+	n := new(big.Int).Mul(big.NewInt(int64(d)), big.NewInt(int64(rate.EditRate)*num))
+	n.Div(n, big.NewInt(den*int64(time.Second)))
+	return TimecodeFromFrames(n.Int64(), rate, dropFrame)
+}
+
+// Validate checks that tc is a valid timecode for rate.
+func (tc Timecode) Validate(rate TimecodeRate) error {
+	_, err := tc.FrameCount(rate)
+	return err
+}
+
+// FrameCount returns the number of frames from 00:00:00:00 to tc.
+func (tc Timecode) FrameCount(rate TimecodeRate) (int64, error) {
+	if err := rate.validate(); err != nil {
+		return 0, fmt.Errorf("Timecode.FrameCount: %w", err)
+	}
+	if tc.Hours < 0 || tc.Hours > 23 || tc.Minutes < 0 || tc.Minutes > 59 || tc.Seconds < 0 || tc.Seconds > 59 ||
+		tc.Frames < 0 || tc.Frames >= rate.EditRate {
+		return 0, fmt.Errorf("Timecode.FrameCount: timecode %s out of range for edit rate %d", tc, rate.EditRate)
+	}
+
+	fps := int64(rate.EditRate)
+	minutes := int64(tc.Hours*60 + tc.Minutes)
+	n := (minutes*60+int64(tc.Seconds))*fps + int64(tc.Frames)
+
+	if tc.DropFrame {
+		drop, err := rate.dropFrames()
+		if err != nil {
+			return 0, fmt.Errorf("Timecode.FrameCount: %w", err)
+		}
+		if tc.Seconds == 0 && tc.Minutes%10 != 0 && int64(tc.Frames) < drop {
+			return 0, fmt.Errorf("Timecode.FrameCount: timecode %s is dropped", tc)
+		}
+		n -= drop * (minutes - minutes/10)
+	}
+	return n, nil
+}
+
+// Duration returns the offset of the start of frame tc from 00:00:00:00, rounded up to the next nanosecond.
+func (tc Timecode) Duration(rate TimecodeRate) (time.Duration, error) {
+	n, err := tc.FrameCount(rate)
+	if err != nil {
+		return 0, err
+	}
+	num, den := rate.factor()
+
+	// d = ceil(n * den * 1e9 / (editRate * num))
+	q := new(big.Int).Mul(big.NewInt(n), big.NewInt(den*int64(time.Second)))
+	d := big.NewInt(int64(rate.EditRate) * num)
+	q.Add(q, d).Sub(q, big.NewInt(1)).Div(q, d)
+	return time.Duration(q.Int64()), nil
+}
+
+// Add returns tc shifted by the frames of o. The result keeps the drop-frame mode of tc.
+func (tc Timecode) Add(o Timecode, rate TimecodeRate) (Timecode, error) {
+	return tc.addFrames(o, rate, 1)
+}
+
+// Sub returns tc shifted back by the frames of o. The result keeps the drop-frame mode of tc.
+func (tc Timecode) Sub(o Timecode, rate TimecodeRate) (Timecode, error) {
+	return tc.addFrames(o, rate, -1)
+}
+
+func (tc Timecode) addFrames(o Timecode, rate TimecodeRate, sign int64) (Timecode, error) {
+	a, err := tc.FrameCount(rate)
+	if err != nil {
+		return Timecode{}, err
+	}
+	b, err := o.FrameCount(rate)
+	if err != nil {
+		return Timecode{}, err
+	}
+	return TimecodeFromFrames(a+sign*b, rate, tc.DropFrame)
+}
+
+// AddFrames returns tc shifted by n frames.
+func (tc Timecode) AddFrames(n int64, rate TimecodeRate) (Timecode, error) {
+	a, err := tc.FrameCount(rate)
+	if err != nil {
+		return Timecode{}, err
+	}
+	return TimecodeFromFrames(a+n, rate, tc.DropFrame)
+}
+
+// Compare returns -1, 0 or +1 depending on whether tc is before, equal or after o when counted in rate.
+func (tc Timecode) Compare(o Timecode, rate TimecodeRate) (int, error) {
+	a, err := tc.FrameCount(rate)
+	if err != nil {
+		return 0, err
+	}
+	b, err := o.FrameCount(rate)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case a < b:
+		return -1, nil
+	case a > b:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (tc Timecode) String() string {
+	return string(tc.appendFormat(nil))
+}
+
+func (tc Timecode) appendFormat(b []byte) []byte {
+	b = appendInt(b, tc.Hours, 2)
+	b = append(b, ':')
+	b = appendInt(b, tc.Minutes, 2)
+	b = append(b, ':')
+	b = appendInt(b, tc.Seconds, 2)
+	if tc.DropFrame {
+		b = append(b, ';')
+	} else {
+		b = append(b, ':')
+	}
+	return appendInt(b, tc.Frames, 2)
+}
+
+func (tc Timecode) MarshalText() ([]byte, error) {
+	if tc.Hours < 0 || tc.Minutes < 0 || tc.Seconds < 0 || tc.Frames < 0 {
+		return nil, errors.New("Timecode.MarshalText: negative component")
+	}
+	return tc.appendFormat(make([]byte, 0, 11)), nil
+}
+
+func (tc *Timecode) UnmarshalText(text []byte) error {
+	tc0, err := ParseTimecode(string(text))
+	if err != nil {
+		return err
+	}
+	*tc = tc0
+	return nil
+}
+
+func (tc Timecode) MarshalJSON() ([]byte, error) {
+	s, err := tc.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+func (tc *Timecode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return tc.UnmarshalText([]byte(s))
+}
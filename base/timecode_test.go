@@ -0,0 +1,144 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+func TestTimecodeFrameCount(t *testing.T) {
+	tests := []struct {
+		in     string
+		rate   base.TimecodeRate
+		frames int64
+	}{
+		{"00:00:00:00", base.TimecodeRate25, 0},
+		{"01:00:00:00", base.TimecodeRate25, 90000},
+		{"00:00:59:24", base.TimecodeRate25, 1499},
+		{"00:00:59;29", base.TimecodeRate29_97, 1799},
+		{"00:01:00;02", base.TimecodeRate29_97, 1800},
+		{"00:10:00;00", base.TimecodeRate29_97, 17982},
+		{"01:00:00;00", base.TimecodeRate29_97, 107892},
+		{"23:59:59;29", base.TimecodeRate29_97, 2589407},
+		{"00:01:00;04", base.TimecodeRate59_94, 3600},
+		{"00:01:00:00", base.TimecodeRate29_97, 1800},
+	}
+
+	for _, tc := range tests {
+		tcode, err := base.ParseTimecode(tc.in)
+		if err != nil {
+			t.Errorf("ParseTimecode(%q): unexpected error: %s", tc.in, err)
+			continue
+		}
+		n, err := tcode.FrameCount(tc.rate)
+		if err != nil || n != tc.frames {
+			t.Errorf("ParseTimecode(%q).FrameCount() = %d (%v); want %d", tc.in, n, err, tc.frames)
+		}
+		back, err := base.TimecodeFromFrames(n, tc.rate, tcode.DropFrame)
+		if err != nil || back.String() != tc.in {
+			t.Errorf("TimecodeFromFrames(%d) = %s (%v); want %s", n, back, err, tc.in)
+		}
+	}
+}
+
+func TestTimecodeInvalid(t *testing.T) {
+	for _, in := range []string{"", "00:00:00", "24:00:00:00", "00:60:00:00", "00:00:00-00", "0:00:00:00"} {
+		if _, err := base.ParseTimecode(in); err == nil {
+			t.Errorf("ParseTimecode(%q): expected error", in)
+		}
+	}
+
+	for _, tc := range []struct {
+		in   string
+		rate base.TimecodeRate
+	}{
+		{"00:00:00:25", base.TimecodeRate25},
+		{"00:01:00;00", base.TimecodeRate29_97},
+		{"00:01:00;03", base.TimecodeRate59_94},
+		{"00:00:00;00", base.TimecodeRate25},
+	} {
+		tcode, err := base.ParseTimecode(tc.in)
+		if err != nil {
+			t.Fatalf("ParseTimecode(%q): unexpected error: %s", tc.in, err)
+		}
+		if err = tcode.Validate(tc.rate); err == nil {
+			t.Errorf("ParseTimecode(%q).Validate(): expected error", tc.in)
+		}
+	}
+}
+
+func TestTimecodeDuration(t *testing.T) {
+	tcode, _ := base.ParseTimecode("00:01:00;02")
+	d, err := tcode.Duration(base.TimecodeRate29_97)
+	if want := 60060 * time.Millisecond; err != nil || d != want {
+		t.Errorf("Duration() = %s (%v); want %s", d, err, want)
+	}
+
+	back, err := base.TimecodeFromDuration(d, base.TimecodeRate29_97, true)
+	if err != nil || back != tcode {
+		t.Errorf("TimecodeFromDuration(%s) = %s (%v); want %s", d, back, err, tcode)
+	}
+
+	back, err = base.TimecodeFromDuration(d-time.Nanosecond, base.TimecodeRate29_97, true)
+	if err != nil || back.String() != "00:00:59;29" {
+		t.Errorf("TimecodeFromDuration(%s) = %s (%v); want 00:00:59;29", d-time.Nanosecond, back, err)
+	}
+
+	tcode, _ = base.ParseTimecode("00:00:01:12")
+	if d, err = tcode.Duration(base.TimecodeRate25); err != nil || d != 1480*time.Millisecond {
+		t.Errorf("Duration() = %s (%v); want 1.48s", d, err)
+	}
+}
+
+func TestTimecodeArithmetic(t *testing.T) {
+	a, _ := base.ParseTimecode("00:00:59;29")
+	b, _ := base.ParseTimecode("00:00:00;01")
+
+	sum, err := a.Add(b, base.TimecodeRate29_97)
+	if err != nil || sum.String() != "00:01:00;02" {
+		t.Errorf("Add() = %s (%v); want 00:01:00;02", sum, err)
+	}
+	diff, err := sum.Sub(b, base.TimecodeRate29_97)
+	if err != nil || diff != a {
+		t.Errorf("Sub() = %s (%v); want %s", diff, err, a)
+	}
+
+	c, _ := base.ParseTimecode("00:00:00:00")
+	wrapped, err := c.AddFrames(-1, base.TimecodeRate25)
+	if err != nil || wrapped.String() != "23:59:59:24" {
+		t.Errorf("AddFrames(-1) = %s (%v); want 23:59:59:24", wrapped, err)
+	}
+
+	if cmp, err := a.Compare(sum, base.TimecodeRate29_97); err != nil || cmp != -1 {
+		t.Errorf("Compare() = %d (%v); want -1", cmp, err)
+	}
+}
+
+func TestTimecodeJSON(t *testing.T) {
+	var tc base.Timecode
+	if err := json.Unmarshal([]byte(`"10:00:00,12"`), &tc); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	b, err := json.Marshal(tc)
+	if err != nil || string(b) != `"10:00:00;12"` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+}
@@ -16,10 +16,545 @@ limitations under the License.
 
 package base
 
-import "net/url"
+import (
+	"encoding"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+)
 
+// URI is a URI reference according to RFC 3986, i.e. either an absolute URI or a relative reference. URIs are
+// validated when unmarshalled.
+//
+// See https://www.rfc-editor.org/rfc/rfc3986
 type URI string
 
+var (
+	_ encoding.TextUnmarshaler = new(URI)
+)
+
 func (u URI) URL() (*url.URL, error) {
 	return url.Parse(string(u))
 }
+
+// uriComponents are the components of a URI reference as split by RFC 3986, Appendix B.
+type uriComponents struct {
+	scheme, authority, path, query, fragment       string
+	hasScheme, hasAuthority, hasQuery, hasFragment bool
+}
+
+func splitURI(s string) uriComponents {
+	var c uriComponents
+
+	if i := strings.IndexAny(s, ":/?#"); i > 0 && s[i] == ':' {
+		c.scheme, s, c.hasScheme = s[:i], s[i+1:], true
+	}
+	if strings.HasPrefix(s, "//") {
+		s = s[2:]
+		i := strings.IndexAny(s, "/?#")
+		if i < 0 {
+			i = len(s)
+		}
+		c.authority, s, c.hasAuthority = s[:i], s[i:], true
+	}
+	if i := strings.IndexByte(s, '#'); i >= 0 {
+		s, c.fragment, c.hasFragment = s[:i], s[i+1:], true
+	}
+	if i := strings.IndexByte(s, '?'); i >= 0 {
+		s, c.query, c.hasQuery = s[:i], s[i+1:], true
+	}
+	c.path = s
+	return c
+}
+
+func (c uriComponents) String() string {
+	var b strings.Builder
+	if c.hasScheme {
+		b.WriteString(c.scheme)
+		b.WriteByte(':')
+	}
+	if c.hasAuthority {
+		b.WriteString("//")
+		b.WriteString(c.authority)
+	}
+	b.WriteString(c.path)
+	if c.hasQuery {
+		b.WriteByte('?')
+		b.WriteString(c.query)
+	}
+	if c.hasFragment {
+		b.WriteByte('#')
+		b.WriteString(c.fragment)
+	}
+	return b.String()
+}
+
+// Validate checks that u is a syntactically valid URI reference according to RFC 3986.
+func (u URI) Validate() error {
+	c := splitURI(string(u))
+
+	if c.hasScheme && !isScheme(c.scheme) {
+		return fmt.Errorf("URI.Validate: invalid scheme in %q", u)
+	}
+	if c.hasAuthority {
+		if err := validateAuthority(c.authority); err != nil {
+			return fmt.Errorf("URI.Validate: %w in %q", err, u)
+		}
+	}
+	if !c.hasAuthority && strings.HasPrefix(c.path, "//") {
+		return fmt.Errorf("URI.Validate: path must not start with \"//\" in %q", u)
+	}
+	if !c.hasScheme {
+		if seg, _, _ := strings.Cut(c.path, "/"); strings.Contains(seg, ":") {
+			return fmt.Errorf("URI.Validate: first path segment of relative reference contains ':' in %q", u)
+		}
+	}
+	if !isURIChars(c.path, pcharSet+"/") {
+		return fmt.Errorf("URI.Validate: invalid path in %q", u)
+	}
+	if !isURIChars(c.query, pcharSet+"/?") {
+		return fmt.Errorf("URI.Validate: invalid query in %q", u)
+	}
+	if !isURIChars(c.fragment, pcharSet+"/?") {
+		return fmt.Errorf("URI.Validate: invalid fragment in %q", u)
+	}
+	return nil
+}
+
+// IsAbsolute reports whether u has a scheme.
+func (u URI) IsAbsolute() bool {
+	return splitURI(string(u)).hasScheme
+}
+
+// ResolveReference resolves u as reference relative to the absolute URI base according to RFC 3986, Section 5.2.
+func (u URI) ResolveReference(base URI) (URI, error) {
+	if err := u.Validate(); err != nil {
+		return "", err
+	}
+	if !base.IsAbsolute() {
+		return "", fmt.Errorf("URI.ResolveReference: base %q is not absolute", base)
+	}
+	if err := base.Validate(); err != nil {
+		return "", err
+	}
+
+	r := splitURI(string(u))
+	b := splitURI(string(base))
+	var t uriComponents
+
+	switch {
+	case r.hasScheme:
+		t = r
+		t.path = removeDotSegments(r.path)
+	case r.hasAuthority:
+		t = r
+		t.scheme, t.hasScheme = b.scheme, true
+		t.path = removeDotSegments(r.path)
+	default:
+		t.scheme, t.hasScheme = b.scheme, true
+		t.authority, t.hasAuthority = b.authority, b.hasAuthority
+		switch {
+		case r.path == "":
+			t.path = b.path
+			t.query, t.hasQuery = b.query, b.hasQuery
+			if r.hasQuery {
+				t.query, t.hasQuery = r.query, true
+			}
+		case r.path[0] == '/':
+			t.path = removeDotSegments(r.path)
+			t.query, t.hasQuery = r.query, r.hasQuery
+		default:
+			t.path = removeDotSegments(mergePaths(b, r.path))
+			t.query, t.hasQuery = r.query, r.hasQuery
+		}
+	}
+	t.fragment, t.hasFragment = r.fragment, r.hasFragment
+
+	return URI(t.String()), nil
+}
+
+// Normalize returns u in normalized form according to RFC 3986, Section 6.2.2 and 6.2.3: scheme and host are lowercased,
+// percent-encodings use uppercase hexadecimal digits, percent-encoded unreserved characters are decoded, dot segments
+// are removed, default ports of well-known schemes are omitted and an empty path of http(s) URIs is replaced by "/".
+// The "urn" scheme and namespace identifier of URNs are lowercased as well.
+func (u URI) Normalize() (URI, error) {
+	if err := u.Validate(); err != nil {
+		return "", err
+	}
+
+	c := splitURI(string(u))
+	c.scheme = strings.ToLower(c.scheme)
+	c.path = normalizePercentEncoding(c.path)
+	c.query = normalizePercentEncoding(c.query)
+	c.fragment = normalizePercentEncoding(c.fragment)
+
+	if c.hasAuthority {
+		userinfo, hostport, ok := strings.Cut(c.authority, "@")
+		if !ok {
+			userinfo, hostport = "", userinfo
+		}
+		host, port := splitHostPort(hostport)
+		host = normalizePercentEncodingCase(strings.ToLower(normalizePercentEncoding(host)))
+		if port == defaultPorts[c.scheme] {
+			port = ""
+		}
+
+		var b strings.Builder
+		if ok {
+			b.WriteString(normalizePercentEncoding(userinfo))
+			b.WriteByte('@')
+		}
+		b.WriteString(host)
+		if port != "" {
+			b.WriteByte(':')
+			b.WriteString(port)
+		}
+		c.authority = b.String()
+
+		if c.path == "" && (c.scheme == "http" || c.scheme == "https") {
+			c.path = "/"
+		}
+	}
+
+	if c.hasScheme && (c.hasAuthority || strings.HasPrefix(c.path, "/")) {
+		c.path = removeDotSegments(c.path)
+	}
+
+	if c.scheme == "urn" {
+		if nid, nss, ok := strings.Cut(c.path, ":"); ok {
+			c.path = strings.ToLower(nid) + ":" + nss
+		}
+	}
+
+	return URI(c.String()), nil
+}
+
+func (u *URI) UnmarshalText(text []byte) error {
+	u0 := URI(text)
+	if err := u0.Validate(); err != nil {
+		return err
+	}
+	*u = u0
+	return nil
+}
+
+// URN is a Uniform Resource Name according to RFC 8141, e.g. "urn:mpeg:mpegi:nbmp:2023".
+//
+// See https://www.rfc-editor.org/rfc/rfc8141
+type URN struct {
+	// Namespace identifier
+	NID string
+
+	// Namespace specific string
+	NSS string
+
+	// r-component (without leading "?+")
+	// +optional
+	RComponent string
+
+	// q-component (without leading "?=")
+	// +optional
+	QComponent string
+
+	// f-component (without leading "#")
+	// +optional
+	FComponent string
+}
+
+// URN parses u as URN according to RFC 8141.
+func (u URI) URN() (*URN, error) {
+	s := string(u)
+	if len(s) < 4 || !strings.EqualFold(s[:4], "urn:") {
+		return nil, fmt.Errorf("URI.URN: %q is not a URN", u)
+	}
+	s = s[4:]
+
+	nid, s, ok := strings.Cut(s, ":")
+	if !ok || !isNID(nid) {
+		return nil, fmt.Errorf("URI.URN: invalid namespace identifier in %q", u)
+	}
+
+	urn := &URN{NID: nid}
+	if i := strings.IndexByte(s, '#'); i >= 0 {
+		urn.FComponent, s = s[i+1:], s[:i]
+		if !isURIChars(urn.FComponent, pcharSet+"/?") {
+			return nil, fmt.Errorf("URI.URN: invalid f-component in %q", u)
+		}
+	}
+	if i := strings.Index(s, "?="); i >= 0 {
+		urn.QComponent, s = s[i+2:], s[:i]
+		if urn.QComponent == "" || !isURIChars(urn.QComponent, pcharSet+"/?") {
+			return nil, fmt.Errorf("URI.URN: invalid q-component in %q", u)
+		}
+	}
+	if i := strings.Index(s, "?+"); i >= 0 {
+		urn.RComponent, s = s[i+2:], s[:i]
+		if urn.RComponent == "" || !isURIChars(urn.RComponent, pcharSet+"/?") {
+			return nil, fmt.Errorf("URI.URN: invalid r-component in %q", u)
+		}
+	}
+
+	urn.NSS = s
+	if s == "" || s[0] == '/' || !isURIChars(s, pcharSet+"/") {
+		return nil, fmt.Errorf("URI.URN: invalid namespace specific string in %q", u)
+	}
+
+	return urn, nil
+}
+
+// Equal reports whether u and o are URN-equivalent according to RFC 8141, Section 3.1, i.e. the "urn" scheme and the
+// NID are compared case-insensitively, percent-encodings are compared case-insensitively and r-, q- and f-components are
+// ignored.
+func (u URN) Equal(o URN) bool {
+	return strings.EqualFold(u.NID, o.NID) && normalizePercentEncodingCase(u.NSS) == normalizePercentEncodingCase(o.NSS)
+}
+
+func (u URN) String() string {
+	var b strings.Builder
+	b.WriteString("urn:")
+	b.WriteString(u.NID)
+	b.WriteByte(':')
+	b.WriteString(u.NSS)
+	if u.RComponent != "" {
+		b.WriteString("?+")
+		b.WriteString(u.RComponent)
+	}
+	if u.QComponent != "" {
+		b.WriteString("?=")
+		b.WriteString(u.QComponent)
+	}
+	if u.FComponent != "" {
+		b.WriteByte('#')
+		b.WriteString(u.FComponent)
+	}
+	return b.String()
+}
+
+// URI returns u as URI.
+func (u URN) URI() URI {
+	return URI(u.String())
+}
+
+const (
+	unreservedSet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~"
+	subDelimsSet  = "!$&'()*+,;="
+	pcharSet      = unreservedSet + subDelimsSet + ":@"
+)
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+	"rtmp":  "1935",
+	"rtsp":  "554",
+}
+
+func isScheme(s string) bool {
+	if s == "" || !isAlpha(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if c := s[i]; !isAlpha(c) && !isDigit(c) && c != '+' && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func isNID(s string) bool {
+	if len(s) < 2 || len(s) > 32 || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !isAlpha(c) && !isDigit(c) && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// isURIChars reports whether s only consists of characters in allowed or valid percent-encodings.
+func isURIChars(s, allowed string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' {
+			if i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+				return false
+			}
+			i += 2
+			continue
+		}
+		if strings.IndexByte(allowed, c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func validateAuthority(a string) error {
+	userinfo, hostport, ok := strings.Cut(a, "@")
+	if !ok {
+		hostport = userinfo
+	} else if !isURIChars(userinfo, unreservedSet+subDelimsSet+":") {
+		return fmt.Errorf("invalid userinfo")
+	}
+
+	host, port := splitHostPort(hostport)
+	for i := 0; i < len(port); i++ {
+		if !isDigit(port[i]) {
+			return fmt.Errorf("invalid port")
+		}
+	}
+
+	if strings.HasPrefix(host, "[") {
+		if !strings.HasSuffix(host, "]") {
+			return fmt.Errorf("invalid IP literal")
+		}
+		lit := host[1 : len(host)-1]
+		if len(lit) > 0 && (lit[0] == 'v' || lit[0] == 'V') {
+			// IPvFuture
+			ver, rest, ok := strings.Cut(lit[1:], ".")
+			if !ok || ver == "" || rest == "" || strings.Trim(ver, "0123456789abcdefABCDEF") != "" ||
+				!isURIChars(rest, unreservedSet+subDelimsSet+":") || strings.Contains(rest, "%") {
+				return fmt.Errorf("invalid IP literal")
+			}
+			return nil
+		}
+		if addr, err := netip.ParseAddr(lit); err != nil || !addr.Is6() || addr.Zone() != "" {
+			return fmt.Errorf("invalid IP literal")
+		}
+		return nil
+	}
+
+	if !isURIChars(host, unreservedSet+subDelimsSet) {
+		return fmt.Errorf("invalid host")
+	}
+	return nil
+}
+
+// splitHostPort splits the host and port of an authority without userinfo.
+func splitHostPort(hostport string) (host, port string) {
+	i := strings.LastIndexByte(hostport, ':')
+	if i < 0 || strings.LastIndexByte(hostport, ']') > i {
+		return hostport, ""
+	}
+	return hostport[:i], hostport[i+1:]
+}
+
+// removeDotSegments implements RFC 3986, Section 5.2.4.
+func removeDotSegments(in string) string {
+	var out []string
+	for in != "" {
+		switch {
+		case strings.HasPrefix(in, "../"):
+			in = in[3:]
+		case strings.HasPrefix(in, "./"):
+			in = in[2:]
+		case strings.HasPrefix(in, "/./"):
+			in = in[2:]
+		case in == "/.":
+			in = "/"
+		case strings.HasPrefix(in, "/../"):
+			in = in[3:]
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "/..":
+			in = "/"
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "." || in == "..":
+			in = ""
+		default:
+			i := strings.IndexByte(in[1:], '/')
+			if i < 0 {
+				i = len(in)
+			} else {
+				i++
+			}
+			out = append(out, in[:i])
+			in = in[i:]
+		}
+	}
+	return strings.Join(out, "")
+}
+
+// mergePaths implements RFC 3986, Section 5.2.3.
+func mergePaths(base uriComponents, ref string) string {
+	if base.hasAuthority && base.path == "" {
+		return "/" + ref
+	}
+	i := strings.LastIndexByte(base.path, '/')
+	return base.path[:i+1] + ref
+}
+
+// normalizePercentEncoding uppercases percent-encodings and decodes percent-encoded unreserved characters.
+func normalizePercentEncoding(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) {
+			c := unhex(s[i+1])<<4 | unhex(s[i+2])
+			if strings.IndexByte(unreservedSet, c) >= 0 {
+				b.WriteByte(c)
+			} else {
+				b.WriteByte('%')
+				b.WriteString(strings.ToUpper(s[i+1 : i+3]))
+			}
+			i += 2
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// normalizePercentEncodingCase uppercases percent-encodings.
+func normalizePercentEncodingCase(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+
+	b := []byte(s)
+	for i := 0; i < len(b); i++ {
+		if b[i] == '%' && i+2 < len(b) {
+			b[i+1] = upper(b[i+1])
+			b[i+2] = upper(b[i+2])
+			i += 2
+		}
+	}
+	return string(b)
+}
+
+func isAlpha(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isHex(c byte) bool {
+	return isDigit(c) || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+func unhex(c byte) byte {
+	switch {
+	case isDigit(c):
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+func TestURIValidate(t *testing.T) {
+	valid := []base.URI{
+		"",
+		"http://nagare.media/",
+		"rtmp://user:pass@[2001:db8::1]:1935/app/input?x=1#y",
+		"http://[v7.fe80::a+en1]/",
+		"urn:mpeg:mpegi:nbmp:2023",
+		"mailto:info@nagare.media",
+		"../relative/path%20with%20space",
+		"./a:b",
+		"#fragment",
+	}
+	for _, u := range valid {
+		if err := u.Validate(); err != nil {
+			t.Errorf("URI(%q).Validate(): unexpected error: %s", u, err)
+		}
+	}
+
+	invalid := []base.URI{
+		"http://nagare media/",
+		"1http://nagare.media/",
+		"http://nagare.media:80a/",
+		"http://[::1/",
+		"http://[fe80::1%25en0]/",
+		"a:b/c d",
+		"a:b%2",
+		"a:b?%zz",
+		"a:b#c#d",
+		"1a:b",
+	}
+	for _, u := range invalid {
+		if err := u.Validate(); err == nil {
+			t.Errorf("URI(%q).Validate(): expected error", u)
+		}
+	}
+}
+
+func TestURIResolveReference(t *testing.T) {
+	// RFC 3986, Section 5.4
+	const b = base.URI("http://a/b/c/d;p?q")
+	tests := []struct {
+		ref, want base.URI
+	}{
+		{"g:h", "g:h"},
+		{"g", "http://a/b/c/g"},
+		{"./g", "http://a/b/c/g"},
+		{"g/", "http://a/b/c/g/"},
+		{"/g", "http://a/g"},
+		{"//g", "http://g"},
+		{"?y", "http://a/b/c/d;p?y"},
+		{"g?y", "http://a/b/c/g?y"},
+		{"#s", "http://a/b/c/d;p?q#s"},
+		{"g#s", "http://a/b/c/g#s"},
+		{";x", "http://a/b/c/;x"},
+		{"", "http://a/b/c/d;p?q"},
+		{".", "http://a/b/c/"},
+		{"./", "http://a/b/c/"},
+		{"..", "http://a/b/"},
+		{"../g", "http://a/b/g"},
+		{"../..", "http://a/"},
+		{"../../g", "http://a/g"},
+		{"../../../g", "http://a/g"},
+		{"/./g", "http://a/g"},
+		{"g.", "http://a/b/c/g."},
+		{"./../g", "http://a/b/g"},
+		{"g;x=1/../y", "http://a/b/c/y"},
+	}
+
+	for _, tc := range tests {
+		got, err := tc.ref.ResolveReference(b)
+		if err != nil {
+			t.Errorf("URI(%q).ResolveReference(): unexpected error: %s", tc.ref, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("URI(%q).ResolveReference() = %q; want %q", tc.ref, got, tc.want)
+		}
+	}
+
+	if _, err := base.URI("g").ResolveReference("relative/base"); err == nil {
+		t.Error("expected error for relative base")
+	}
+}
+
+func TestURINormalize(t *testing.T) {
+	tests := []struct {
+		in, want base.URI
+	}{
+		{"HTTP://Nagare.Media", "http://nagare.media/"},
+		{"https://nagare.media:443/a/./b/../c", "https://nagare.media/a/c"},
+		{"http://nagare.media:8080/%7efoo%2f%c3%a4", "http://nagare.media:8080/~foo%2F%C3%A4"},
+		{"URN:MPEG:mpegi:NBMP", "urn:mpeg:mpegi:NBMP"},
+		{"../a/./b", "../a/./b"},
+	}
+
+	for _, tc := range tests {
+		got, err := tc.in.Normalize()
+		if err != nil {
+			t.Errorf("URI(%q).Normalize(): unexpected error: %s", tc.in, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("URI(%q).Normalize() = %q; want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestURIURN(t *testing.T) {
+	tests := []struct {
+		in   base.URI
+		want *base.URN
+	}{
+		{"urn:mpeg:mpegi:nbmp:2023", &base.URN{NID: "mpeg", NSS: "mpegi:nbmp:2023"}},
+		{"URN:ISBN:978-3-16-148410-0", &base.URN{NID: "ISBN", NSS: "978-3-16-148410-0"}},
+		{"urn:example:a/b?+r=1?=q=2#f", &base.URN{NID: "example", NSS: "a/b", RComponent: "r=1", QComponent: "q=2", FComponent: "f"}},
+	}
+
+	for _, tc := range tests {
+		got, err := tc.in.URN()
+		if err != nil {
+			t.Errorf("URI(%q).URN(): unexpected error: %s", tc.in, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("URI(%q).URN() mismatch (-want +got):\n%s", tc.in, diff)
+		}
+	}
+
+	for _, in := range []base.URI{"http://nagare.media", "urn:x:abc", "urn:-ab:abc", "urn:example:", "urn:example:/a", "urn:example:a b"} {
+		if _, err := in.URN(); err == nil {
+			t.Errorf("URI(%q).URN(): expected error", in)
+		}
+	}
+
+	a, _ := base.URI("urn:example:a%2fb").URN()
+	b, _ := base.URI("URN:EXAMPLE:a%2Fb?=q").URN()
+	if !a.Equal(*b) {
+		t.Errorf("expected %s and %s to be equivalent", a, b)
+	}
+}
+
+func TestURIUnmarshal(t *testing.T) {
+	var v struct {
+		URI base.URI `json:"uri"`
+	}
+	if err := json.Unmarshal([]byte(`{"uri":"http://nagare.media/"}`), &v); err != nil || v.URI != "http://nagare.media/" {
+		t.Errorf("unexpected result %q (%v)", v.URI, err)
+	}
+	if err := json.Unmarshal([]byte(`{"uri":"http://nagare media/"}`), &v); err == nil {
+		t.Error("expected error for invalid URI")
+	}
+}
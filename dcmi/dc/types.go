@@ -16,7 +16,10 @@ limitations under the License.
 
 package dc
 
-import "github.com/nagare-media/models.go/third_party/encoding/xml"
+import (
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
 
 const (
 	SchemaVersion = "2008-02-11"
@@ -31,9 +34,9 @@ const (
 //
 // This complexType allows for restriction or extension permitting child elements.
 type SimpleLiteral struct {
-	Value   string `xml:",chardata" json:"#value,omitempty"`
-	XMLLang string `xml:"http://www.w3.org/XML/1998/namespace xml:lang,attr,omitempty" json:"@xml:lang,omitempty"`
-	XSIType string `xml:"http://www.w3.org/2001/XMLSchema-instance xsi:type,attr,omitempty" json:"@xsi:type,omitempty"`
+	Value   string           `xml:",chardata" json:"#value,omitempty"`
+	XMLLang base.LanguageTag `xml:"http://www.w3.org/XML/1998/namespace xml:lang,attr,omitempty" json:"@xml:lang,omitempty"`
+	XSIType string           `xml:"http://www.w3.org/2001/XMLSchema-instance xsi:type,attr,omitempty" json:"@xsi:type,omitempty"`
 }
 
 type Any SimpleLiteral
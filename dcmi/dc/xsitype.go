@@ -0,0 +1,109 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownXSIType is returned by Typed if no parser is registered for the xsi:type of a literal.
+var ErrUnknownXSIType = errors.New("unknown xsi:type")
+
+// XSITypeParser parses the value of a literal with a specific xsi:type into a typed value.
+// +kubebuilder:object:generate=false
+type XSITypeParser func(value string) (any, error)
+
+var (
+	xsiTypesMu sync.RWMutex
+	xsiTypes   = make(map[string]XSITypeParser)
+)
+
+// RegisterXSIType registers the parser for literals with the given xsi:type QName, e.g. "dcterms:W3CDTF". Encoding
+// schemes of the dcterms package are registered when the package is imported. RegisterXSIType panics if a parser is
+// registered twice for the same xsi:type or if p is nil.
+func RegisterXSIType(xsiType string, p XSITypeParser) {
+	xsiTypesMu.Lock()
+	defer xsiTypesMu.Unlock()
+
+	if p == nil {
+		panic("dc.RegisterXSIType: parser is nil")
+	}
+	if _, dup := xsiTypes[xsiType]; dup {
+		panic("dc.RegisterXSIType: called twice for xsi:type " + xsiType)
+	}
+	xsiTypes[xsiType] = p
+}
+
+// XSITypes returns a sorted list of all registered xsi:types.
+func XSITypes() []string {
+	xsiTypesMu.RLock()
+	defer xsiTypesMu.RUnlock()
+
+	l := make([]string, 0, len(xsiTypes))
+	for t := range xsiTypes {
+		l = append(l, t)
+	}
+	sort.Strings(l)
+	return l
+}
+
+// ParseXSIType parses value with the parser registered for xsiType. Values without xsi:type are returned as string.
+func ParseXSIType(xsiType, value string) (any, error) {
+	if xsiType == "" {
+		return value, nil
+	}
+
+	xsiTypesMu.RLock()
+	p, ok := xsiTypes[xsiType]
+	xsiTypesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("dc.ParseXSIType: %w %q", ErrUnknownXSIType, xsiType)
+	}
+
+	v, err := p(value)
+	if err != nil {
+		return nil, fmt.Errorf("dc.ParseXSIType: invalid %s value %q: %w", xsiType, value, err)
+	}
+	return v, nil
+}
+
+// Typed returns the value parsed according to its xsi:type, e.g. a base.DateTime for "dcterms:W3CDTF". Literals without
+// xsi:type are returned as string. An error wrapping ErrUnknownXSIType is returned if no parser is registered for the
+// xsi:type.
+func (l *SimpleLiteral) Typed() (any, error) {
+	return ParseXSIType(l.XSIType, l.Value)
+}
+
+func (l *Any) Typed() (any, error)         { return (*SimpleLiteral)(l).Typed() }
+func (l *Title) Typed() (any, error)       { return (*SimpleLiteral)(l).Typed() }
+func (l *Creator) Typed() (any, error)     { return (*SimpleLiteral)(l).Typed() }
+func (l *Subject) Typed() (any, error)     { return (*SimpleLiteral)(l).Typed() }
+func (l *Description) Typed() (any, error) { return (*SimpleLiteral)(l).Typed() }
+func (l *Publisher) Typed() (any, error)   { return (*SimpleLiteral)(l).Typed() }
+func (l *Contributor) Typed() (any, error) { return (*SimpleLiteral)(l).Typed() }
+func (l *Date) Typed() (any, error)        { return (*SimpleLiteral)(l).Typed() }
+func (l *Type) Typed() (any, error)        { return (*SimpleLiteral)(l).Typed() }
+func (l *Format) Typed() (any, error)      { return (*SimpleLiteral)(l).Typed() }
+func (l *Identifier) Typed() (any, error)  { return (*SimpleLiteral)(l).Typed() }
+func (l *Source) Typed() (any, error)      { return (*SimpleLiteral)(l).Typed() }
+func (l *Language) Typed() (any, error)    { return (*SimpleLiteral)(l).Typed() }
+func (l *Relation) Typed() (any, error)    { return (*SimpleLiteral)(l).Typed() }
+func (l *Coverage) Typed() (any, error)    { return (*SimpleLiteral)(l).Typed() }
+func (l *Rights) Typed() (any, error)      { return (*SimpleLiteral)(l).Typed() }
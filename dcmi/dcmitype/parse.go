@@ -0,0 +1,79 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcmitype
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Values are all classes of the DCMI Type Vocabulary.
+var Values = []DCMIType{
+	Collection,
+	Dataset,
+	Event,
+	Image,
+	InteractiveResource,
+	MovingImage,
+	PhysicalObject,
+	Service,
+	Software,
+	Sound,
+	StillImage,
+	Text,
+}
+
+// Parse parses a DCMI type given as bare name ("MovingImage"), prefixed name ("dcmitype:MovingImage") or URI
+// ("http://purl.org/dc/dcmitype/MovingImage"). Names are matched case-insensitively and surrounding whitespace is
+// ignored.
+func Parse(s string) (DCMIType, error) {
+	name := strings.TrimSpace(s)
+	switch {
+	case hasPrefixFold(name, XMLNS):
+		name = name[len(XMLNS):]
+	case hasPrefixFold(name, "https://purl.org/dc/dcmitype/"):
+		name = name[len("https://purl.org/dc/dcmitype/"):]
+	case hasPrefixFold(name, XMLNSPrefix+":"):
+		name = name[len(XMLNSPrefix)+1:]
+	}
+
+	for _, t := range Values {
+		if strings.EqualFold(name, string(t)) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("dcmitype.Parse: unknown DCMI type %q", s)
+}
+
+// IsValid reports whether t is a class of the DCMI Type Vocabulary in its canonical form.
+func (t DCMIType) IsValid() bool {
+	for _, v := range Values {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// URI returns the URI of t, e.g. "http://purl.org/dc/dcmitype/MovingImage".
+func (t DCMIType) URI() string {
+	return XMLNS + string(t)
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcmitype_test
+
+import (
+	"testing"
+
+	"github.com/nagare-media/models.go/dcmi/dcmitype"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want dcmitype.DCMIType
+	}{
+		{"MovingImage", dcmitype.MovingImage},
+		{"movingimage", dcmitype.MovingImage},
+		{" Text ", dcmitype.Text},
+		{"dcmitype:Sound", dcmitype.Sound},
+		{"http://purl.org/dc/dcmitype/StillImage", dcmitype.StillImage},
+		{"HTTP://PURL.ORG/dc/dcmitype/Dataset", dcmitype.Dataset},
+	}
+
+	for _, tc := range tests {
+		got, err := dcmitype.Parse(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("Parse(%q) = %q (%v); want %q", tc.in, got, err, tc.want)
+		}
+	}
+
+	for _, in := range []string{"", "Movie", "http://purl.org/dc/terms/Text", "dcmitype:"} {
+		if _, err := dcmitype.Parse(in); err == nil {
+			t.Errorf("Parse(%q): expected error", in)
+		}
+	}
+
+	if !dcmitype.Image.IsValid() || dcmitype.DCMIType("image").IsValid() {
+		t.Error("unexpected IsValid result")
+	}
+	if got := dcmitype.Event.URI(); got != "http://purl.org/dc/dcmitype/Event" {
+		t.Errorf("URI() = %q", got)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/nagare-media/models.go/dcmi/dc"
+)
+
+// refinements maps the DCMI terms that refine one of the 15 DC elements to that element according to the
+// rdfs:subPropertyOf relations of the DCMI Metadata Terms.
+//
+// See https://www.dublincore.org/specifications/dublin-core/dcmi-terms/
+var refinements = map[string]string{
+	"alternative": "title",
+
+	"tableOfContents": "description",
+	"abstract":        "description",
+
+	"created":         "date",
+	"valid":           "date",
+	"available":       "date",
+	"issued":          "date",
+	"modified":        "date",
+	"dateAccepted":    "date",
+	"dateCopyrighted": "date",
+	"dateSubmitted":   "date",
+
+	"extent": "format",
+	"medium": "format",
+
+	"isVersionOf":    "relation",
+	"hasVersion":     "relation",
+	"isReplacedBy":   "relation",
+	"replaces":       "relation",
+	"isRequiredBy":   "relation",
+	"requires":       "relation",
+	"isPartOf":       "relation",
+	"hasPart":        "relation",
+	"isReferencedBy": "relation",
+	"references":     "relation",
+	"isFormatOf":     "relation",
+	"hasFormat":      "relation",
+	"conformsTo":     "relation",
+
+	"spatial":  "coverage",
+	"temporal": "coverage",
+
+	"accessRights": "rights",
+	"license":      "rights",
+
+	"bibliographicCitation": "identifier",
+}
+
+// ElementsReport lists the information lost when converting Terms to dc.Elements.
+type ElementsReport struct {
+	// Values of refinements that were folded into their parent element. The values are preserved, but not the
+	// refinement, e.g. that a date is the date of issue.
+	Folded []TermValue
+
+	// Values of terms without a corresponding element, e.g. dcterms:audience.
+	Dropped []TermValue
+}
+
+// TermValue is a value of a DCMI term reported by ElementsReport.
+type TermValue struct {
+	// QName of the term, e.g. "dcterms:abstract".
+	Term string
+
+	// QName of the element the value was folded into, e.g. "dc:description". Empty if the value was dropped.
+	// +optional
+	Element string
+
+	Value dc.SimpleLiteral
+}
+
+// Lossless reports whether no information was lost.
+func (r *ElementsReport) Lossless() bool {
+	return len(r.Folded) == 0 && len(r.Dropped) == 0
+}
+
+// FromElements returns the terms corresponding to the DC elements of e. Every element is mapped to the term of the same
+// name, e.g. dc:title to dcterms:title, so that no information is lost.
+func FromElements(e *dc.Elements) *Terms {
+	if e == nil {
+		return nil
+	}
+
+	t := &Terms{}
+	terms := literalFields(t)
+	_ = walkRDFFields(reflect.ValueOf(e).Elem(), func(predicate string, f reflect.Value) error {
+		dst := terms[localName(predicate)]
+		dst.Set(appendLiterals(dst, f))
+		return nil
+	})
+	return t
+}
+
+// ToElements returns the DC elements corresponding to t. The 15 terms with the names of the DC elements are mapped to
+// those elements. Refinements are folded into the element they refine, e.g. dcterms:abstract into dc:description and
+// dcterms:issued into dc:date. Values of the remaining terms, e.g. dcterms:audience or dcterms:rightsHolder, are
+// dropped. The report lists all folded and dropped values.
+func (t *Terms) ToElements() (*dc.Elements, *ElementsReport) {
+	e := &dc.Elements{}
+	r := &ElementsReport{}
+	elements := literalFields(e)
+	_ = walkRDFFields(reflect.ValueOf(t).Elem(), func(predicate string, f reflect.Value) error {
+		term := localName(predicate)
+		element := term
+		if parent, ok := refinements[term]; ok {
+			element = parent
+		}
+
+		dst, ok := elements[element]
+		if ok {
+			dst.Set(appendLiterals(dst, f))
+		}
+		if ok && element == term {
+			return nil
+		}
+
+		for i := 0; i < f.Len(); i++ {
+			v := TermValue{
+				Term:  XMLNSPrefix + ":" + term,
+				Value: f.Index(i).Convert(simpleLiteralType).Interface().(dc.SimpleLiteral),
+			}
+			if ok {
+				v.Element = dc.XMLNSPrefix + ":" + element
+				r.Folded = append(r.Folded, v)
+			} else {
+				r.Dropped = append(r.Dropped, v)
+			}
+		}
+		return nil
+	})
+	return e, r
+}
+
+// literalFields returns the literal slice fields of the struct v points to by local name.
+func literalFields(v any) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+	_ = walkRDFFields(reflect.ValueOf(v).Elem(), func(predicate string, f reflect.Value) error {
+		fields[localName(predicate)] = f
+		return nil
+	})
+	return fields
+}
+
+func localName(predicate string) string {
+	return predicate[strings.LastIndexByte(predicate, '/')+1:]
+}
+
+// appendLiterals appends the literals of src to dst converting them to the element type of dst.
+func appendLiterals(dst, src reflect.Value) reflect.Value {
+	for i := 0; i < src.Len(); i++ {
+		dst = reflect.Append(dst, src.Index(i).Convert(dst.Type().Elem()))
+	}
+	return dst
+}
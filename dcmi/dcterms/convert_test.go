@@ -0,0 +1,109 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/dcmi/dc"
+	"github.com/nagare-media/models.go/dcmi/dcterms"
+)
+
+func TestFromElements(t *testing.T) {
+	e := &dc.Elements{
+		Title:    []dc.Title{{Value: "Sintel", XMLLang: "en"}},
+		Date:     []dc.Date{{Value: "2010-09-27", XSIType: dcterms.W3CDTFXSIType}},
+		Relation: []dc.Relation{{Value: "https://durian.blender.org"}},
+		Rights:   []dc.Rights{{Value: "CC BY 3.0"}},
+	}
+	want := &dcterms.Terms{
+		Title:    []dcterms.Title{{Value: "Sintel", XMLLang: "en"}},
+		Date:     []dcterms.Date{{Value: "2010-09-27", XSIType: dcterms.W3CDTFXSIType}},
+		Relation: []dcterms.Relation{{Value: "https://durian.blender.org"}},
+		Rights:   []dcterms.Rights{{Value: "CC BY 3.0"}},
+	}
+
+	got := dcterms.FromElements(e)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+
+	back, report := got.ToElements()
+	if diff := cmp.Diff(e, back); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+	if !report.Lossless() {
+		t.Errorf("expected lossless conversion, got %+v", report)
+	}
+}
+
+func TestToElements(t *testing.T) {
+	terms := &dcterms.Terms{
+		Title:        []dcterms.Title{{Value: "Sintel"}},
+		Alternative:  []dcterms.Alternative{{Value: "Durian"}},
+		Description:  []dcterms.Description{{Value: "A short film."}},
+		Abstract:     []dcterms.Abstract{{Value: "A girl searches for her dragon.", XMLLang: "en"}},
+		Created:      []dcterms.Created{{Value: "2010", XSIType: dcterms.W3CDTFXSIType}},
+		Issued:       []dcterms.Issued{{Value: "2010-09-27", XSIType: dcterms.W3CDTFXSIType}},
+		IsPartOf:     []dcterms.IsPartOf{{Value: "Blender Open Movies"}},
+		License:      []dcterms.License{{Value: "https://creativecommons.org/licenses/by/3.0/", XSIType: dcterms.URIXSIType}},
+		Audience:     []dcterms.Audience{{Value: "General"}},
+		RightsHolder: []dcterms.RightsHolder{{Value: "Blender Foundation"}},
+	}
+
+	got, report := terms.ToElements()
+	want := &dc.Elements{
+		Title:       []dc.Title{{Value: "Sintel"}, {Value: "Durian"}},
+		Description: []dc.Description{{Value: "A short film."}, {Value: "A girl searches for her dragon.", XMLLang: "en"}},
+		Date: []dc.Date{
+			{Value: "2010", XSIType: dcterms.W3CDTFXSIType},
+			{Value: "2010-09-27", XSIType: dcterms.W3CDTFXSIType},
+		},
+		Relation: []dc.Relation{{Value: "Blender Open Movies"}},
+		Rights:   []dc.Rights{{Value: "https://creativecommons.org/licenses/by/3.0/", XSIType: dcterms.URIXSIType}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	wantReport := &dcterms.ElementsReport{
+		Folded: []dcterms.TermValue{
+			{Term: "dcterms:alternative", Element: "dc:title", Value: dc.SimpleLiteral{Value: "Durian"}},
+			{Term: "dcterms:abstract", Element: "dc:description",
+				Value: dc.SimpleLiteral{Value: "A girl searches for her dragon.", XMLLang: "en"}},
+			{Term: "dcterms:created", Element: "dc:date",
+				Value: dc.SimpleLiteral{Value: "2010", XSIType: dcterms.W3CDTFXSIType}},
+			{Term: "dcterms:issued", Element: "dc:date",
+				Value: dc.SimpleLiteral{Value: "2010-09-27", XSIType: dcterms.W3CDTFXSIType}},
+			{Term: "dcterms:isPartOf", Element: "dc:relation", Value: dc.SimpleLiteral{Value: "Blender Open Movies"}},
+			{Term: "dcterms:license", Element: "dc:rights",
+				Value: dc.SimpleLiteral{Value: "https://creativecommons.org/licenses/by/3.0/", XSIType: dcterms.URIXSIType}},
+		},
+		Dropped: []dcterms.TermValue{
+			{Term: "dcterms:audience", Value: dc.SimpleLiteral{Value: "General"}},
+			{Term: "dcterms:rightsHolder", Value: dc.SimpleLiteral{Value: "Blender Foundation"}},
+		},
+	}
+	if diff := cmp.Diff(wantReport, report); diff != "" {
+		t.Errorf("report mismatch (-want +got):\n%s", diff)
+	}
+	if report.Lossless() {
+		t.Error("expected lossy conversion")
+	}
+}
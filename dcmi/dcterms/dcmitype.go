@@ -0,0 +1,90 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nagare-media/models.go/dcmi/dc"
+	"github.com/nagare-media/models.go/dcmi/dcmitype"
+)
+
+// DCMITypeError reports a type entry with xsi:type="dcterms:DCMIType" that is not a class of the DCMI Type Vocabulary.
+// +kubebuilder:object:generate=false
+type DCMITypeError struct {
+	// Path of the entry, e.g. "dcterms:type[1]".
+	Field string
+
+	// Value of the entry.
+	Value string
+
+	Err error
+}
+
+func (e *DCMITypeError) Error() string {
+	return fmt.Sprintf("%s: invalid DCMI type %q", e.Field, e.Value)
+}
+
+func (e *DCMITypeError) Unwrap() error {
+	return e.Err
+}
+
+func validateDCMIType(field string, i int, l *dc.SimpleLiteral) error {
+	if l.XSIType != DCMITypeXSIType {
+		return nil
+	}
+	if _, err := dcmitype.Parse(l.Value); err != nil {
+		return &DCMITypeError{Field: fmt.Sprintf("%s[%d]", field, i), Value: l.Value, Err: err}
+	}
+	return nil
+}
+
+// ValidateDCMITypes checks that all dc:type entries of e with xsi:type="dcterms:DCMIType" are classes of the DCMI Type
+// Vocabulary. The returned error joins a *DCMITypeError for each invalid entry.
+func ValidateDCMITypes(e *dc.Elements) error {
+	var errs []error
+	for i := range e.Type {
+		errs = append(errs, validateDCMIType("dc:type", i, (*dc.SimpleLiteral)(&e.Type[i])))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateDCMITypes checks that all dcterms:type entries of t with xsi:type="dcterms:DCMIType" are classes of the DCMI
+// Type Vocabulary. The returned error joins a *DCMITypeError for each invalid entry.
+func (t *Terms) ValidateDCMITypes() error {
+	var errs []error
+	for i := range t.Type {
+		errs = append(errs, validateDCMIType("dcterms:type", i, (*dc.SimpleLiteral)(&t.Type[i])))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateDCMITypes checks that all dc:type entries of e with xsi:type="dcterms:DCMIType" are classes of the DCMI Type
+// Vocabulary. The returned error joins a *DCMITypeError for each invalid entry.
+func (e *Elements) ValidateDCMITypes() error {
+	var errs []error
+	for i := range e.DCType {
+		errs = append(errs, validateDCMIType("dc:type", i, (*dc.SimpleLiteral)(&e.DCType[i])))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateDCMITypes checks the type entries of both, DC elements and DCMI terms (see Terms.ValidateDCMITypes).
+func (et *ElementsAndTerms) ValidateDCMITypes() error {
+	return errors.Join(et.Elements.ValidateDCMITypes(), et.Terms.ValidateDCMITypes())
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nagare-media/models.go/dcmi/dc"
+	"github.com/nagare-media/models.go/dcmi/dcterms"
+)
+
+func TestValidateDCMITypes(t *testing.T) {
+	e := &dc.Elements{
+		Type: []dc.Type{
+			{Value: "MovingImage", XSIType: dcterms.DCMITypeXSIType},
+			{Value: "Movie"},
+			{Value: "Movie", XSIType: dcterms.DCMITypeXSIType},
+		},
+	}
+	err := dcterms.ValidateDCMITypes(e)
+	var typeErr *dcterms.DCMITypeError
+	if !errors.As(err, &typeErr) || typeErr.Field != "dc:type[2]" || typeErr.Value != "Movie" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	terms := &dcterms.ElementsAndTerms{
+		Elements: dcterms.Elements{
+			DCType: []dc.Type{{Value: "http://purl.org/dc/dcmitype/Text", XSIType: dcterms.DCMITypeXSIType}},
+		},
+		Terms: dcterms.Terms{
+			Type: []dcterms.Type{
+				{Value: "sound", XSIType: dcterms.DCMITypeXSIType},
+				{Value: "Video", XSIType: dcterms.DCMITypeXSIType},
+			},
+		},
+	}
+	err = terms.ValidateDCMITypes()
+	if !errors.As(err, &typeErr) || typeErr.Field != "dcterms:type[1]" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	terms.Terms.Type = terms.Terms.Type[:1]
+	if err = terms.ValidateDCMITypes(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
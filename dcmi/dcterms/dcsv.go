@@ -0,0 +1,471 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+const (
+	// W3CDTFScheme is the default scheme of DCMI Period values.
+	W3CDTFScheme = "W3C-DTF"
+
+	// DecimalDegreesUnits is the default units of DCMI Point and Box values.
+	DecimalDegreesUnits = "signed decimal degrees"
+
+	// MetresZUnits is the default zunits of DCMI Point and Box values.
+	MetresZUnits = "metres"
+)
+
+// dcsvComponent is a labelled component of a Dublin Core Structured Value (DCSV).
+type dcsvComponent struct {
+	label string
+	value string
+}
+
+// parseDCSV parses a DCSV string, e.g. "name=The Great Depression; start=1929; end=1939;". Components are separated by
+// ";" and labels by "=". Both characters may be escaped with "\". Whitespace around labels and values is ignored.
+//
+// See https://www.dublincore.org/specifications/dublin-core/dcmi-dcsv/
+func parseDCSV(s string) ([]dcsvComponent, error) {
+	var (
+		comps []dcsvComponent
+		cur   strings.Builder
+		label string
+		hasLb bool
+	)
+
+	flush := func() error {
+		v := strings.TrimSpace(cur.String())
+		cur.Reset()
+		if !hasLb && v == "" {
+			return nil
+		}
+		if hasLb && label == "" {
+			return errors.New("empty label")
+		}
+		comps = append(comps, dcsvComponent{label: label, value: v})
+		label, hasLb = "", false
+		return nil
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				cur.WriteByte(s[i])
+			}
+		case '=':
+			if hasLb {
+				return nil, fmt.Errorf("unescaped '=' in value at offset %d", i)
+			}
+			label, hasLb = strings.ToLower(strings.TrimSpace(cur.String())), true
+			cur.Reset()
+		case ';':
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return comps, nil
+}
+
+// appendDCSV appends a DCSV component if value is not empty.
+func appendDCSV(b []byte, label, value string) []byte {
+	if value == "" {
+		return b
+	}
+	if len(b) > 0 {
+		b = append(b, "; "...)
+	}
+	b = append(b, label...)
+	b = append(b, '=')
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; c == ';' || c == '=' || c == '\\' {
+			b = append(b, '\\')
+		}
+		b = append(b, value[i])
+	}
+	return b
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatFloatPtr(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return formatFloat(*f)
+}
+
+func parseFloatPtr(s string) (*float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// PeriodValue is a structured value of the DCMI Period encoding scheme, e.g.
+// "name=The Great Depression; start=1929; end=1939; scheme=W3C-DTF".
+//
+// See https://www.dublincore.org/specifications/dublin-core/dcmi-period/
+type PeriodValue struct {
+	// Name of the time interval.
+	// +optional
+	Name string
+
+	// Start of the time interval. Empty if the start is open.
+	// +optional
+	Start string
+
+	// End of the time interval. Empty if the end is open.
+	// +optional
+	End string
+
+	// Encoding scheme of start and end. Empty means W3CDTFScheme.
+	// +optional
+	Scheme string
+}
+
+var (
+	_ encoding.TextMarshaler   = &PeriodValue{}
+	_ encoding.TextUnmarshaler = &PeriodValue{}
+)
+
+// ParsePeriod parses a value of the DCMI Period encoding scheme. Unknown components are ignored.
+func ParsePeriod(s string) (PeriodValue, error) {
+	var p PeriodValue
+	comps, err := parseDCSV(s)
+	if err != nil {
+		return p, fmt.Errorf("ParsePeriod: %w in %q", err, s)
+	}
+	for _, c := range comps {
+		switch c.label {
+		case "name":
+			p.Name = c.value
+		case "start":
+			p.Start = c.value
+		case "end":
+			p.End = c.value
+		case "scheme":
+			p.Scheme = c.value
+		}
+	}
+	if p.Name == "" && p.Start == "" && p.End == "" {
+		return PeriodValue{}, fmt.Errorf("ParsePeriod: neither name, start nor end given in %q", s)
+	}
+	return p, nil
+}
+
+// Interval returns the period as time interval. The scheme has to be W3C-DTF.
+func (p PeriodValue) Interval() (base.Interval, error) {
+	if p.Scheme != "" && p.Scheme != W3CDTFScheme {
+		return base.Interval{}, fmt.Errorf("PeriodValue.Interval: unsupported scheme %q", p.Scheme)
+	}
+	var i base.Interval
+	if p.Start != "" {
+		start, err := base.ParseDate(p.Start)
+		if err != nil {
+			return base.Interval{}, fmt.Errorf("PeriodValue.Interval: %w", err)
+		}
+		i.Start = &start
+	}
+	if p.End != "" {
+		end, err := base.ParseDate(p.End)
+		if err != nil {
+			return base.Interval{}, fmt.Errorf("PeriodValue.Interval: %w", err)
+		}
+		i.End = &end
+	}
+	if i.Start == nil && i.End == nil {
+		return base.Interval{}, errors.New("PeriodValue.Interval: period without start and end")
+	}
+	return i, nil
+}
+
+// Temporal returns the period as dcterms:temporal value with the dcterms:Period xsi:type.
+func (p PeriodValue) Temporal() Temporal {
+	return Temporal{Value: p.String(), XSIType: PeriodXSIType}
+}
+
+func (p PeriodValue) String() string {
+	var b []byte
+	b = appendDCSV(b, "name", p.Name)
+	b = appendDCSV(b, "start", p.Start)
+	b = appendDCSV(b, "end", p.End)
+	b = appendDCSV(b, "scheme", p.Scheme)
+	return string(b)
+}
+
+func (p PeriodValue) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PeriodValue) UnmarshalText(text []byte) error {
+	p0, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = p0
+	return nil
+}
+
+// PointValue is a structured value of the DCMI Point encoding scheme, e.g.
+// "east=148.26218; north=-36.45746; elevation=2228; name=Mt. Kosciusko".
+//
+// See https://www.dublincore.org/specifications/dublin-core/dcmi-point/
+type PointValue struct {
+	// Longitude or easting.
+	East float64
+
+	// Latitude or northing.
+	North float64
+
+	// Elevation of the point.
+	// +optional
+	Elevation *float64
+
+	// Units of east and north. Empty means DecimalDegreesUnits.
+	// +optional
+	Units string
+
+	// Units of the elevation. Empty means MetresZUnits.
+	// +optional
+	ZUnits string
+
+	// Name of the projection of east and north.
+	// +optional
+	Projection string
+
+	// Name of the place.
+	// +optional
+	Name string
+}
+
+var (
+	_ encoding.TextMarshaler   = &PointValue{}
+	_ encoding.TextUnmarshaler = &PointValue{}
+)
+
+// ParsePoint parses a value of the DCMI Point encoding scheme. Unknown components are ignored.
+func ParsePoint(s string) (PointValue, error) {
+	var p PointValue
+	comps, err := parseDCSV(s)
+	if err != nil {
+		return p, fmt.Errorf("ParsePoint: %w in %q", err, s)
+	}
+
+	var hasEast, hasNorth bool
+	for _, c := range comps {
+		switch c.label {
+		case "east":
+			p.East, err = strconv.ParseFloat(c.value, 64)
+			hasEast = true
+		case "north":
+			p.North, err = strconv.ParseFloat(c.value, 64)
+			hasNorth = true
+		case "elevation":
+			p.Elevation, err = parseFloatPtr(c.value)
+		case "units":
+			p.Units = c.value
+		case "zunits":
+			p.ZUnits = c.value
+		case "projection":
+			p.Projection = c.value
+		case "name":
+			p.Name = c.value
+		}
+		if err != nil {
+			return PointValue{}, fmt.Errorf("ParsePoint: invalid %s in %q", c.label, s)
+		}
+	}
+	if !hasEast || !hasNorth {
+		return PointValue{}, fmt.Errorf("ParsePoint: east and north are required in %q", s)
+	}
+	return p, nil
+}
+
+// Spatial returns the point as dcterms:spatial value with the dcterms:Point xsi:type.
+func (p PointValue) Spatial() Spatial {
+	return Spatial{Value: p.String(), XSIType: PointXSIType}
+}
+
+func (p PointValue) String() string {
+	var b []byte
+	b = appendDCSV(b, "east", formatFloat(p.East))
+	b = appendDCSV(b, "north", formatFloat(p.North))
+	b = appendDCSV(b, "elevation", formatFloatPtr(p.Elevation))
+	b = appendDCSV(b, "units", p.Units)
+	b = appendDCSV(b, "zunits", p.ZUnits)
+	b = appendDCSV(b, "projection", p.Projection)
+	b = appendDCSV(b, "name", p.Name)
+	return string(b)
+}
+
+func (p PointValue) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PointValue) UnmarshalText(text []byte) error {
+	p0, err := ParsePoint(string(text))
+	if err != nil {
+		return err
+	}
+	*p = p0
+	return nil
+}
+
+// BoxValue is a structured value of the DCMI Box encoding scheme, e.g.
+// "name=Western Australia; northlimit=-13.5; southlimit=-35.5; westlimit=112.5; eastlimit=129".
+//
+// See https://www.dublincore.org/specifications/dublin-core/dcmi-box/
+type BoxValue struct {
+	// Northern limit as latitude or northing.
+	NorthLimit float64
+
+	// Eastern limit as longitude or easting.
+	EastLimit float64
+
+	// Southern limit as latitude or northing.
+	SouthLimit float64
+
+	// Western limit as longitude or easting.
+	WestLimit float64
+
+	// Upper limit of the elevation.
+	// +optional
+	UpLimit *float64
+
+	// Lower limit of the elevation.
+	// +optional
+	DownLimit *float64
+
+	// Units of the limits. Empty means DecimalDegreesUnits.
+	// +optional
+	Units string
+
+	// Units of the elevation limits. Empty means MetresZUnits.
+	// +optional
+	ZUnits string
+
+	// Name of the projection of the limits.
+	// +optional
+	Projection string
+
+	// Name of the region.
+	// +optional
+	Name string
+}
+
+var (
+	_ encoding.TextMarshaler   = &BoxValue{}
+	_ encoding.TextUnmarshaler = &BoxValue{}
+)
+
+// ParseBox parses a value of the DCMI Box encoding scheme. Unknown components are ignored.
+func ParseBox(s string) (BoxValue, error) {
+	var b BoxValue
+	comps, err := parseDCSV(s)
+	if err != nil {
+		return b, fmt.Errorf("ParseBox: %w in %q", err, s)
+	}
+
+	var limits int
+	for _, c := range comps {
+		switch c.label {
+		case "northlimit":
+			b.NorthLimit, err = strconv.ParseFloat(c.value, 64)
+			limits |= 1
+		case "eastlimit":
+			b.EastLimit, err = strconv.ParseFloat(c.value, 64)
+			limits |= 2
+		case "southlimit":
+			b.SouthLimit, err = strconv.ParseFloat(c.value, 64)
+			limits |= 4
+		case "westlimit":
+			b.WestLimit, err = strconv.ParseFloat(c.value, 64)
+			limits |= 8
+		case "uplimit":
+			b.UpLimit, err = parseFloatPtr(c.value)
+		case "downlimit":
+			b.DownLimit, err = parseFloatPtr(c.value)
+		case "units":
+			b.Units = c.value
+		case "zunits":
+			b.ZUnits = c.value
+		case "projection":
+			b.Projection = c.value
+		case "name":
+			b.Name = c.value
+		}
+		if err != nil {
+			return BoxValue{}, fmt.Errorf("ParseBox: invalid %s in %q", c.label, s)
+		}
+	}
+	if limits != 15 {
+		return BoxValue{}, fmt.Errorf("ParseBox: northlimit, eastlimit, southlimit and westlimit are required in %q", s)
+	}
+	return b, nil
+}
+
+// Spatial returns the box as dcterms:spatial value with the dcterms:Box xsi:type.
+func (b BoxValue) Spatial() Spatial {
+	return Spatial{Value: b.String(), XSIType: BoxXSIType}
+}
+
+func (b BoxValue) String() string {
+	var s []byte
+	s = appendDCSV(s, "name", b.Name)
+	s = appendDCSV(s, "northlimit", formatFloat(b.NorthLimit))
+	s = appendDCSV(s, "eastlimit", formatFloat(b.EastLimit))
+	s = appendDCSV(s, "southlimit", formatFloat(b.SouthLimit))
+	s = appendDCSV(s, "westlimit", formatFloat(b.WestLimit))
+	s = appendDCSV(s, "uplimit", formatFloatPtr(b.UpLimit))
+	s = appendDCSV(s, "downlimit", formatFloatPtr(b.DownLimit))
+	s = appendDCSV(s, "units", b.Units)
+	s = appendDCSV(s, "zunits", b.ZUnits)
+	s = appendDCSV(s, "projection", b.Projection)
+	return string(s)
+}
+
+func (b BoxValue) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *BoxValue) UnmarshalText(text []byte) error {
+	b0, err := ParseBox(string(text))
+	if err != nil {
+		return err
+	}
+	*b = b0
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/dcmi/dcterms"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestParsePeriod(t *testing.T) {
+	p, err := dcterms.ParsePeriod("name=The Great Depression; start=1929; end=1939;")
+	if err != nil {
+		t.Fatalf("ParsePeriod: unexpected error: %s", err)
+	}
+	want := dcterms.PeriodValue{Name: "The Great Depression", Start: "1929", End: "1939"}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("ParsePeriod mismatch (-want +got):\n%s", diff)
+	}
+	if got := p.String(); got != "name=The Great Depression; start=1929; end=1939" {
+		t.Errorf("String() = %q", got)
+	}
+
+	i, err := p.Interval()
+	if err != nil {
+		t.Fatalf("Interval: unexpected error: %s", err)
+	}
+	if !i.Contains(time.Date(1939, 12, 31, 0, 0, 0, 0, time.UTC)) || i.Contains(time.Date(1940, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected interval %s", i)
+	}
+
+	escaped := dcterms.PeriodValue{Name: `A\; B=C`, Start: "2000", Scheme: "other"}
+	p, err = dcterms.ParsePeriod(escaped.String())
+	if err != nil || p != escaped {
+		t.Errorf("round trip of %q = %+v (%v)", escaped.String(), p, err)
+	}
+	if _, err = p.Interval(); err == nil {
+		t.Error("expected error for unsupported scheme")
+	}
+
+	for _, in := range []string{"", "scheme=W3C-DTF", "name=a=b", "=x"} {
+		if _, err := dcterms.ParsePeriod(in); err == nil {
+			t.Errorf("ParsePeriod(%q): expected error", in)
+		}
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	p, err := dcterms.ParsePoint("east=148.26218; north=-36.45746; elevation=2228; name=Mt. Kosciusko")
+	if err != nil {
+		t.Fatalf("ParsePoint: unexpected error: %s", err)
+	}
+	want := dcterms.PointValue{East: 148.26218, North: -36.45746, Elevation: ptr(2228.0), Name: "Mt. Kosciusko"}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("ParsePoint mismatch (-want +got):\n%s", diff)
+	}
+	if got := p.String(); got != "east=148.26218; north=-36.45746; elevation=2228; name=Mt. Kosciusko" {
+		t.Errorf("String() = %q", got)
+	}
+
+	for _, in := range []string{"east=1", "east=x; north=1", "north=1; name=x"} {
+		if _, err := dcterms.ParsePoint(in); err == nil {
+			t.Errorf("ParsePoint(%q): expected error", in)
+		}
+	}
+}
+
+func TestParseBox(t *testing.T) {
+	b, err := dcterms.ParseBox("name=Lake Eyre; northlimit=-28.1; southlimit=-29.8; westlimit=136.5; eastlimit=137.9; " +
+		"uplimit=0; downlimit=-15; zunits=metres; projection=WGS84")
+	if err != nil {
+		t.Fatalf("ParseBox: unexpected error: %s", err)
+	}
+	want := dcterms.BoxValue{
+		NorthLimit: -28.1,
+		EastLimit:  137.9,
+		SouthLimit: -29.8,
+		WestLimit:  136.5,
+		UpLimit:    ptr(0.0),
+		DownLimit:  ptr(-15.0),
+		ZUnits:     "metres",
+		Projection: "WGS84",
+		Name:       "Lake Eyre",
+	}
+	if diff := cmp.Diff(want, b); diff != "" {
+		t.Errorf("ParseBox mismatch (-want +got):\n%s", diff)
+	}
+
+	back, err := dcterms.ParseBox(b.String())
+	if err != nil {
+		t.Fatalf("ParseBox(%q): unexpected error: %s", b.String(), err)
+	}
+	if diff := cmp.Diff(b, back); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := dcterms.ParseBox("northlimit=1; southlimit=0; westlimit=0"); err == nil {
+		t.Error("expected error for missing eastlimit")
+	}
+}
+
+func TestTyped(t *testing.T) {
+	s := dcterms.PointValue{East: 1, North: 2}.Spatial()
+	v, err := s.Typed()
+	if err != nil {
+		t.Fatalf("Typed: unexpected error: %s", err)
+	}
+	if _, ok := v.(dcterms.PointValue); !ok {
+		t.Errorf("Typed() = %T; want dcterms.PointValue", v)
+	}
+
+	temporal := dcterms.Temporal{Value: "start=2020", XSIType: dcterms.PeriodXSIType}
+	if v, err = temporal.Typed(); err != nil {
+		t.Fatalf("Typed: unexpected error: %s", err)
+	} else if p, ok := v.(dcterms.PeriodValue); !ok || p.Start != "2020" {
+		t.Errorf("Typed() = %#v", v)
+	}
+
+	plain := dcterms.Spatial{Value: "Berlin"}
+	if v, err = plain.Typed(); err != nil || v != "Berlin" {
+		t.Errorf("Typed() = %#v (%v); want \"Berlin\"", v, err)
+	}
+
+	invalid := dcterms.Spatial{Value: "Berlin", XSIType: dcterms.BoxXSIType}
+	if _, err = invalid.Typed(); err == nil {
+		t.Error("expected error for invalid box")
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/dcmi/dc"
+)
+
+const (
+	// RDFNS is the namespace IRI of the DCMI terms in RDF. In contrast to XMLNS it ends with a "/".
+	RDFNS = "http://purl.org/dc/terms/"
+
+	// XSDNS is the namespace IRI of the XML Schema datatypes in RDF.
+	XSDNS = "http://www.w3.org/2001/XMLSchema#"
+
+	xsdString     = XSDNS + "string"
+	rdfLangString = "http://www.w3.org/1999/02/22-rdf-syntax-ns#langString"
+)
+
+// rdfPrefixes are the prefixes used in Turtle and JSON-LD. The prefixes are also used to map xsi:type QNames to
+// datatype IRIs.
+var rdfPrefixes = []struct{ prefix, ns string }{
+	{dc.XMLNSPrefix, dc.XMLNS},
+	{XMLNSPrefix, RDFNS},
+	{"xsd", XSDNS},
+	{"xs", XSDNS},
+}
+
+// Triple is an RDF statement about the resource Subject.
+type Triple struct {
+	// IRI of the subject or a blank node identifier of the form "_:b0".
+	Subject string
+
+	// IRI of the predicate, e.g. "http://purl.org/dc/terms/title".
+	Predicate string
+
+	Object Object
+}
+
+// Object is the object of a Triple. It is either an IRI or a literal with an optional language tag or datatype.
+type Object struct {
+	// IRI of the object. Literal, Language and Datatype are ignored if IRI is set.
+	// +optional
+	IRI string
+
+	// Lexical form of the literal.
+	// +optional
+	Literal string
+
+	// Language tag of the literal.
+	// +optional
+	Language base.LanguageTag
+
+	// Datatype IRI of the literal.
+	// +optional
+	Datatype string
+}
+
+// Triples returns the RDF statements about subject described by v. v must be a *dc.Elements, *Elements, *Terms or
+// *ElementsAndTerms (or another struct with dc.SimpleLiteral-like slice fields tagged with DC namespaces). The xml:lang
+// of literals is mapped to language-tagged literals and the xsi:type to the datatype, e.g. "dcterms:W3CDTF" becomes
+// http://purl.org/dc/terms/W3CDTF.
+func Triples(subject string, v any) ([]Triple, error) {
+	if err := validateSubject(subject); err != nil {
+		return nil, fmt.Errorf("Triples: %w", err)
+	}
+	rv, err := rdfStruct(v)
+	if err != nil {
+		return nil, fmt.Errorf("Triples: %w", err)
+	}
+
+	var ts []Triple
+	err = walkRDFFields(rv, func(predicate string, f reflect.Value) error {
+		for i := 0; i < f.Len(); i++ {
+			l := f.Index(i).Convert(simpleLiteralType).Interface().(dc.SimpleLiteral)
+			o, err := literalObject(&l)
+			if err != nil {
+				return fmt.Errorf("<%s>[%d]: %w", predicate, i, err)
+			}
+			ts = append(ts, Triple{Subject: subject, Predicate: predicate, Object: o})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Triples: %w", err)
+	}
+	return ts, nil
+}
+
+// FromTriples sets the fields of v according to the RDF statements ts. All statements must be about the same subject,
+// which is returned ("" if ts is empty). Statements with predicates that cannot be represented by v are ignored. IRI objects are
+// represented as literals with xsi:type "dcterms:URI". See Triples for the supported types of v.
+func FromTriples(ts []Triple, v any) (string, error) {
+	rv, err := rdfStruct(v)
+	if err != nil {
+		return "", fmt.Errorf("FromTriples: %w", err)
+	}
+	if len(ts) == 0 {
+		return "", nil
+	}
+
+	subject := ts[0].Subject
+	fields := make(map[string]reflect.Value)
+	_ = walkRDFFields(rv, func(predicate string, f reflect.Value) error {
+		fields[predicate] = f
+		return nil
+	})
+
+	for _, t := range ts {
+		if t.Subject != subject {
+			return "", fmt.Errorf("FromTriples: statements about multiple subjects %q and %q", subject, t.Subject)
+		}
+		f, ok := fields[t.Predicate]
+		if !ok {
+			continue
+		}
+		l, err := objectLiteral(t.Object)
+		if err != nil {
+			return "", fmt.Errorf("FromTriples: <%s>: %w", t.Predicate, err)
+		}
+		f.Set(reflect.Append(f, reflect.ValueOf(l).Convert(f.Type().Elem())))
+	}
+	return subject, nil
+}
+
+var simpleLiteralType = reflect.TypeOf(dc.SimpleLiteral{})
+
+func rdfStruct(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("unsupported type %T", v)
+	}
+	return rv.Elem(), nil
+}
+
+// walkRDFFields calls fn for all slice fields of rv with elements convertible to dc.SimpleLiteral in field order.
+// Embedded structs are walked recursively.
+func walkRDFFields(rv reflect.Value, fn func(predicate string, f reflect.Value) error) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		switch {
+		case sf.Anonymous && sf.Type.Kind() == reflect.Struct:
+			if err := walkRDFFields(rv.Field(i), fn); err != nil {
+				return err
+			}
+		case sf.IsExported() && sf.Type.Kind() == reflect.Slice && sf.Type.Elem().ConvertibleTo(simpleLiteralType):
+			if p := rdfPredicate(sf.Tag.Get("xml")); p != "" {
+				if err := fn(p, rv.Field(i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// rdfPredicate returns the predicate IRI for a struct tag like `xml:"http://purl.org/dc/terms dcterms:title"`.
+func rdfPredicate(tag string) string {
+	tag, _, _ = strings.Cut(tag, ",")
+	ns, name, ok := strings.Cut(tag, " ")
+	if !ok {
+		return ""
+	}
+	if _, local, ok := strings.Cut(name, ":"); ok {
+		name = local
+	}
+	switch ns {
+	case XMLNS:
+		return RDFNS + name
+	case dc.XMLNS:
+		return dc.XMLNS + name
+	}
+	return ""
+}
+
+func validateSubject(s string) error {
+	if strings.HasPrefix(s, "_:") {
+		if !isLocalName(s[2:]) {
+			return fmt.Errorf("invalid blank node %q", s)
+		}
+		return nil
+	}
+	if err := validateIRI(s); err != nil {
+		return fmt.Errorf("invalid subject: %w", err)
+	}
+	return nil
+}
+
+func validateIRI(s string) error {
+	// IRIs allow non-ASCII characters wherever URIs allow unreserved characters
+	u := base.URI(strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII {
+			return 'x'
+		}
+		return r
+	}, s))
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	if !u.IsAbsolute() {
+		return fmt.Errorf("relative IRI %q", s)
+	}
+	return nil
+}
+
+// isLocalName reports whether s is a valid blank node label or local name of a prefixed name without escaping. Only
+// ASCII characters are considered.
+func isLocalName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9') && c != '_' &&
+			(i == 0 || (c != '-' && c != '.')) {
+			return false
+		}
+	}
+	return s[len(s)-1] != '.'
+}
+
+// literalObject maps a literal to an RDF object.
+func literalObject(l *dc.SimpleLiteral) (Object, error) {
+	o := Object{Literal: l.Value, Language: l.XMLLang}
+	if l.XSIType == "" {
+		return o, nil
+	}
+	if l.XMLLang != "" {
+		return Object{}, errors.New("literal with xml:lang and xsi:type cannot be represented in RDF")
+	}
+	dt, err := expandQName(l.XSIType)
+	if err != nil {
+		return Object{}, err
+	}
+	o.Datatype = dt
+	return o, nil
+}
+
+// objectLiteral maps an RDF object to a literal.
+func objectLiteral(o Object) (dc.SimpleLiteral, error) {
+	if o.IRI != "" {
+		return dc.SimpleLiteral{Value: o.IRI, XSIType: URIXSIType}, nil
+	}
+	l := dc.SimpleLiteral{Value: o.Literal, XMLLang: o.Language}
+	switch o.Datatype {
+	case "", xsdString, rdfLangString:
+		return l, nil
+	}
+	qn, ok := compactIRI(o.Datatype)
+	if !ok {
+		return dc.SimpleLiteral{}, fmt.Errorf("unsupported datatype <%s>", o.Datatype)
+	}
+	if strings.HasPrefix(qn, "xsd:") {
+		qn = "xs:" + qn[len("xsd:"):]
+	}
+	l.XSIType = qn
+	return l, nil
+}
+
+// expandQName expands a QName like "dcterms:W3CDTF" to an IRI.
+func expandQName(qn string) (string, error) {
+	prefix, local, ok := strings.Cut(qn, ":")
+	if ok {
+		for _, p := range rdfPrefixes {
+			if p.prefix == prefix {
+				return p.ns + local, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unsupported xsi:type %q", qn)
+}
+
+// compactIRI compacts an IRI to a QName using rdfPrefixes.
+func compactIRI(iri string) (string, bool) {
+	for _, p := range rdfPrefixes {
+		if local, ok := strings.CutPrefix(iri, p.ns); ok && local != "" && !strings.ContainsAny(local, "/#") {
+			return p.prefix + ":" + local, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/dcmi/dc"
+)
+
+// jsonldContext is the @context of JSON-LD documents created by MarshalJSONLD.
+var jsonldContext = map[string]string{
+	dc.XMLNSPrefix: dc.XMLNS,
+	XMLNSPrefix:    RDFNS,
+	"xsd":          XSDNS,
+}
+
+// MarshalJSONLD returns the JSON-LD representation of the RDF statements about subject described by v. The document is
+// compacted with a @context defining the prefixes "dc", "dcterms" and "xsd". See Triples for the supported types of v.
+//
+// See https://www.w3.org/TR/json-ld11/
+func MarshalJSONLD(subject string, v any) ([]byte, error) {
+	ts, err := Triples(subject, v)
+	if err != nil {
+		return nil, fmt.Errorf("MarshalJSONLD: %w", err)
+	}
+
+	node := map[string]any{
+		"@context": jsonldContext,
+		"@id":      subject,
+	}
+	for _, t := range ts {
+		key := t.Predicate
+		if qn, ok := compactIRI(key); ok {
+			key = qn
+		}
+		val := jsonldValue(t.Object)
+		switch prev := node[key].(type) {
+		case nil:
+			node[key] = val
+		case []any:
+			node[key] = append(prev, val)
+		default:
+			node[key] = []any{prev, val}
+		}
+	}
+	return json.Marshal(node)
+}
+
+func jsonldValue(o Object) any {
+	switch {
+	case o.IRI != "":
+		return map[string]string{"@id": o.IRI}
+	case o.Language != "":
+		return map[string]string{"@value": o.Literal, "@language": string(o.Language)}
+	case o.Datatype != "":
+		dt := o.Datatype
+		if qn, ok := compactIRI(dt); ok {
+			dt = qn
+		}
+		return map[string]string{"@value": o.Literal, "@type": dt}
+	}
+	return o.Literal
+}
+
+// UnmarshalJSONLD parses a JSON-LD document describing a single node and sets the fields of v accordingly. The @id of
+// the node is returned; nodes without @id are returned as blank node "_:b0". See FromTriples for details.
+//
+// Only the subset of JSON-LD needed for flat descriptions is supported: embedded contexts with term and prefix
+// definitions, @vocab, @language and type coercion; value objects; and node references as objects. Remote contexts,
+// lists and nested node objects are rejected.
+func UnmarshalJSONLD(data []byte, v any) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return "", fmt.Errorf("UnmarshalJSONLD: %w", err)
+	}
+
+	subject, ts, err := parseJSONLD(doc)
+	if err != nil {
+		return "", fmt.Errorf("UnmarshalJSONLD: %w", err)
+	}
+	if _, err := FromTriples(ts, v); err != nil {
+		return "", fmt.Errorf("UnmarshalJSONLD: %w", err)
+	}
+	return subject, nil
+}
+
+// jsonldTerm is a term definition of a JSON-LD context.
+type jsonldTerm struct {
+	id       string
+	typ      string
+	language *string
+}
+
+type jsonldCtx struct {
+	terms    map[string]jsonldTerm
+	vocab    string
+	language string
+}
+
+func parseJSONLD(doc any) (string, []Triple, error) {
+	ctx := &jsonldCtx{terms: make(map[string]jsonldTerm)}
+
+	// unwrap top-level arrays and @graph
+	node, ok := doc.(map[string]any)
+	for {
+		if !ok {
+			if arr, isArr := doc.([]any); isArr && len(arr) == 1 {
+				doc = arr[0]
+				node, ok = doc.(map[string]any)
+				continue
+			}
+			return "", nil, errors.New("document must contain exactly one node object")
+		}
+		if c, has := node["@context"]; has {
+			if err := ctx.update(c); err != nil {
+				return "", nil, err
+			}
+		}
+		g, has := node["@graph"]
+		if !has {
+			break
+		}
+		doc = g
+		node, ok = g.(map[string]any)
+	}
+
+	subject := "_:b0"
+	if id, has := node["@id"]; has {
+		s, isStr := id.(string)
+		if !isStr {
+			return "", nil, errors.New("@id must be a string")
+		}
+		subject = ctx.expandIRI(s, false)
+		if err := validateSubject(subject); err != nil {
+			return "", nil, err
+		}
+	}
+
+	// map iteration order is random; the order of values per key is kept
+	keys := make([]string, 0, len(node))
+	for key := range node {
+		if !strings.HasPrefix(key, "@") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var ts []Triple
+	for _, key := range keys {
+		val := node[key]
+		predicate := ctx.expandIRI(key, true)
+		if predicate == "" || !strings.Contains(predicate, ":") {
+			// keys not mapped to IRIs are dropped
+			continue
+		}
+		objects, err := ctx.objects(key, val)
+		if err != nil {
+			return "", nil, fmt.Errorf("%q: %w", key, err)
+		}
+		for _, o := range objects {
+			ts = append(ts, Triple{Subject: subject, Predicate: predicate, Object: o})
+		}
+	}
+	return subject, ts, nil
+}
+
+func (c *jsonldCtx) update(v any) error {
+	switch v := v.(type) {
+	case nil:
+		c.terms = make(map[string]jsonldTerm)
+		c.vocab, c.language = "", ""
+	case []any:
+		for _, e := range v {
+			if err := c.update(e); err != nil {
+				return err
+			}
+		}
+	case string:
+		return fmt.Errorf("remote context %q not supported", v)
+	case map[string]any:
+		// @vocab and @language first, as term definitions may depend on them
+		if vocab, ok := v["@vocab"].(string); ok {
+			c.vocab = c.expandIRI(vocab, true)
+		}
+		if lang, ok := v["@language"].(string); ok {
+			c.language = lang
+		}
+		for key, def := range v {
+			if strings.HasPrefix(key, "@") {
+				continue
+			}
+			switch def := def.(type) {
+			case nil:
+				delete(c.terms, key)
+			case string:
+				c.terms[key] = jsonldTerm{id: def}
+			case map[string]any:
+				t := jsonldTerm{id: key}
+				if id, ok := def["@id"].(string); ok {
+					t.id = id
+				}
+				if typ, ok := def["@type"].(string); ok {
+					t.typ = typ
+				}
+				if lang, ok := def["@language"]; ok {
+					s, _ := lang.(string)
+					t.language = &s
+				}
+				if def["@container"] == "@list" {
+					return fmt.Errorf("term %q: lists not supported", key)
+				}
+				c.terms[key] = t
+			default:
+				return fmt.Errorf("invalid definition of term %q", key)
+			}
+		}
+		// term IDs and types may use prefixes defined in the same context
+		for key, t := range c.terms {
+			t.id = c.expandIRI(t.id, true)
+			if t.typ != "" && t.typ != "@id" && t.typ != "@vocab" {
+				t.typ = c.expandIRI(t.typ, true)
+			}
+			c.terms[key] = t
+		}
+	default:
+		return errors.New("invalid @context")
+	}
+	return nil
+}
+
+// expandIRI expands a term, compact IRI or (if vocab is true) vocabulary-relative IRI.
+func (c *jsonldCtx) expandIRI(s string, vocab bool) string {
+	if t, ok := c.terms[s]; ok && vocab && t.id != s {
+		return t.id
+	}
+	if prefix, local, ok := strings.Cut(s, ":"); ok && !strings.HasPrefix(local, "//") {
+		if t, ok := c.terms[prefix]; ok {
+			return t.id + local
+		}
+	}
+	if strings.Contains(s, ":") {
+		return s
+	}
+	if vocab && c.vocab != "" {
+		return c.vocab + s
+	}
+	return s
+}
+
+func (c *jsonldCtx) objects(key string, v any) ([]Object, error) {
+	term := c.terms[key]
+
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		var os []Object
+		for _, e := range v {
+			o, err := c.objects(key, e)
+			if err != nil {
+				return nil, err
+			}
+			os = append(os, o...)
+		}
+		return os, nil
+	case string:
+		switch {
+		case term.typ == "@id":
+			return []Object{{IRI: c.expandIRI(v, false)}}, nil
+		case term.typ == "@vocab":
+			return []Object{{IRI: c.expandIRI(v, true)}}, nil
+		case term.typ != "":
+			return []Object{{Literal: v, Datatype: term.typ}}, nil
+		case term.language != nil:
+			return []Object{{Literal: v, Language: base.LanguageTag(*term.language)}}, nil
+		}
+		return []Object{{Literal: v, Language: base.LanguageTag(c.language)}}, nil
+	case json.Number:
+		dt := XSDNS + "integer"
+		if strings.ContainsAny(v.String(), ".eE") {
+			dt = XSDNS + "double"
+		}
+		if term.typ != "" && term.typ != "@id" && term.typ != "@vocab" {
+			dt = term.typ
+		}
+		return []Object{{Literal: v.String(), Datatype: dt}}, nil
+	case bool:
+		return []Object{{Literal: fmt.Sprint(v), Datatype: XSDNS + "boolean"}}, nil
+	case map[string]any:
+		if set, ok := v["@set"]; ok {
+			return c.objects(key, set)
+		}
+		if _, ok := v["@list"]; ok {
+			return nil, errors.New("lists not supported")
+		}
+		if val, ok := v["@value"]; ok {
+			return c.valueObject(val, v)
+		}
+		if id, ok := v["@id"].(string); ok && len(v) == 1 {
+			return []Object{{IRI: c.expandIRI(id, false)}}, nil
+		}
+		return nil, errors.New("nested node objects not supported")
+	}
+	return nil, fmt.Errorf("invalid value %v", v)
+}
+
+func (c *jsonldCtx) valueObject(val any, v map[string]any) ([]Object, error) {
+	var o Object
+	switch val := val.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		o.Literal = val
+	case json.Number:
+		o.Literal = val.String()
+	case bool:
+		o.Literal = fmt.Sprint(val)
+	default:
+		return nil, errors.New("invalid @value")
+	}
+
+	if lang, ok := v["@language"].(string); ok {
+		o.Language = base.LanguageTag(lang)
+	}
+	if typ, ok := v["@type"].(string); ok {
+		if o.Language != "" {
+			return nil, errors.New("value object with @language and @type")
+		}
+		o.Datatype = c.expandIRI(typ, true)
+	}
+	return []Object{o}, nil
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nagare-media/models.go/base"
+)
+
+// MarshalNTriples returns the N-Triples representation of the RDF statements about subject described by v. See
+// Triples for the supported types of v.
+//
+// See https://www.w3.org/TR/n-triples/
+func MarshalNTriples(subject string, v any) ([]byte, error) {
+	ts, err := Triples(subject, v)
+	if err != nil {
+		return nil, fmt.Errorf("MarshalNTriples: %w", err)
+	}
+
+	var b []byte
+	for _, t := range ts {
+		b = appendNTriplesTerm(b, t.Subject)
+		b = append(b, ' ')
+		b = appendIRI(b, t.Predicate)
+		b = append(b, ' ')
+		b = appendObject(b, t.Object, nil)
+		b = append(b, " .\n"...)
+	}
+	return b, nil
+}
+
+// UnmarshalNTriples parses an N-Triples document and sets the fields of v accordingly. The subject of the statements is
+// returned. See FromTriples for details.
+func UnmarshalNTriples(data []byte, v any) (string, error) {
+	ts, err := ParseNTriples(data)
+	if err != nil {
+		return "", fmt.Errorf("UnmarshalNTriples: %w", err)
+	}
+	subject, err := FromTriples(ts, v)
+	if err != nil {
+		return "", fmt.Errorf("UnmarshalNTriples: %w", err)
+	}
+	return subject, nil
+}
+
+// ParseNTriples parses the statements of an N-Triples document. Blank nodes are not supported as objects.
+func ParseNTriples(data []byte) ([]Triple, error) {
+	if !utf8.Valid(data) {
+		return nil, errors.New("ParseNTriples: invalid UTF-8")
+	}
+
+	var ts []Triple
+	for n, line := range strings.Split(string(data), "\n") {
+		p := ntriplesParser{s: strings.TrimSuffix(line, "\r")}
+		p.skipSpace()
+		if p.s == "" || p.s[0] == '#' {
+			continue
+		}
+		t, err := p.triple()
+		if err != nil {
+			return nil, fmt.Errorf("ParseNTriples: line %d: %w", n+1, err)
+		}
+		ts = append(ts, t)
+	}
+	return ts, nil
+}
+
+type ntriplesParser struct {
+	s string
+}
+
+func (p *ntriplesParser) skipSpace() {
+	p.s = strings.TrimLeft(p.s, " \t")
+}
+
+func (p *ntriplesParser) triple() (Triple, error) {
+	var (
+		t   Triple
+		err error
+	)
+
+	if strings.HasPrefix(p.s, "_:") {
+		t.Subject = p.blankNode()
+		if !isLocalName(t.Subject[2:]) {
+			return Triple{}, fmt.Errorf("invalid blank node %q", t.Subject)
+		}
+	} else if t.Subject, err = p.iri(); err != nil {
+		return Triple{}, err
+	}
+	p.skipSpace()
+
+	if t.Predicate, err = p.iri(); err != nil {
+		return Triple{}, err
+	}
+	p.skipSpace()
+
+	switch {
+	case strings.HasPrefix(p.s, "<"):
+		if t.Object.IRI, err = p.iri(); err != nil {
+			return Triple{}, err
+		}
+	case strings.HasPrefix(p.s, `"`):
+		if t.Object, err = p.literal(); err != nil {
+			return Triple{}, err
+		}
+	case strings.HasPrefix(p.s, "_:"):
+		return Triple{}, errors.New("blank node objects are not supported")
+	default:
+		return Triple{}, fmt.Errorf("invalid object %q", p.s)
+	}
+	p.skipSpace()
+
+	if !strings.HasPrefix(p.s, ".") {
+		return Triple{}, errors.New("missing '.'")
+	}
+	p.s = p.s[1:]
+	p.skipSpace()
+	if p.s != "" && p.s[0] != '#' {
+		return Triple{}, fmt.Errorf("unexpected %q after '.'", p.s)
+	}
+	return t, nil
+}
+
+func (p *ntriplesParser) blankNode() string {
+	i := strings.IndexAny(p.s, " \t")
+	if i < 0 {
+		i = len(p.s)
+	}
+	bn := p.s[:i]
+	p.s = p.s[i:]
+	return bn
+}
+
+func (p *ntriplesParser) iri() (string, error) {
+	if !strings.HasPrefix(p.s, "<") {
+		return "", fmt.Errorf("expected IRI at %q", p.s)
+	}
+	i := strings.IndexByte(p.s, '>')
+	if i < 0 {
+		return "", fmt.Errorf("unterminated IRI %q", p.s)
+	}
+	iri, err := unescapeNTriples(p.s[1:i], false)
+	if err != nil {
+		return "", err
+	}
+	p.s = p.s[i+1:]
+	if err := validateIRI(iri); err != nil {
+		return "", err
+	}
+	return iri, nil
+}
+
+func (p *ntriplesParser) literal() (Object, error) {
+	var o Object
+
+	// find closing quote
+	i := 1
+	for ; i < len(p.s) && p.s[i] != '"'; i++ {
+		if p.s[i] == '\\' {
+			i++
+		}
+	}
+	if i >= len(p.s) {
+		return Object{}, fmt.Errorf("unterminated literal %q", p.s)
+	}
+	lit, err := unescapeNTriples(p.s[1:i], true)
+	if err != nil {
+		return Object{}, err
+	}
+	o.Literal = lit
+	p.s = p.s[i+1:]
+
+	switch {
+	case strings.HasPrefix(p.s, "@"):
+		j := strings.IndexAny(p.s, " \t.")
+		if j < 0 {
+			j = len(p.s)
+		}
+		o.Language = base.LanguageTag(p.s[1:j])
+		if o.Language == "" {
+			return Object{}, errors.New("empty language tag")
+		}
+		p.s = p.s[j:]
+	case strings.HasPrefix(p.s, "^^"):
+		p.s = p.s[2:]
+		if o.Datatype, err = p.iri(); err != nil {
+			return Object{}, err
+		}
+	}
+	return o, nil
+}
+
+// echars are the characters of ECHAR escape sequences and echarValues the characters they represent.
+const (
+	echars      = `tbnrf"'\`
+	echarValues = "\t\b\n\r\f\"'\\"
+)
+
+// unescapeNTriples resolves the escape sequences of IRIs (UCHAR) and literals (UCHAR and ECHAR).
+func unescapeNTriples(s string, literal bool) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		if i+1 >= len(s) {
+			return "", errors.New("incomplete escape sequence")
+		}
+		i++
+		switch c = s[i]; {
+		case c == 'u' || c == 'U':
+			n := 4
+			if c == 'U' {
+				n = 8
+			}
+			if i+1+n > len(s) {
+				return "", fmt.Errorf("incomplete escape sequence %q", s[i-1:])
+			}
+			r, err := strconv.ParseUint(s[i+1:i+1+n], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return "", fmt.Errorf("invalid escape sequence %q", s[i-1:i+1+n])
+			}
+			b.WriteRune(rune(r))
+			i += n
+		case literal && strings.IndexByte(echars, c) >= 0:
+			b.WriteByte(echarValues[strings.IndexByte(echars, c)])
+		default:
+			return "", fmt.Errorf("invalid escape sequence %q", s[i-1:i+1])
+		}
+	}
+	return b.String(), nil
+}
+
+func appendNTriplesTerm(b []byte, s string) []byte {
+	if strings.HasPrefix(s, "_:") {
+		return append(b, s...)
+	}
+	return appendIRI(b, s)
+}
+
+func appendIRI(b []byte, iri string) []byte {
+	b = append(b, '<')
+	for _, r := range iri {
+		if r <= 0x20 || strings.ContainsRune("<>\"{}|^`\\", r) {
+			b = appendUCHAR(b, r)
+			continue
+		}
+		b = utf8.AppendRune(b, r)
+	}
+	return append(b, '>')
+}
+
+// appendObject appends an object in N-Triples syntax. If compact is not nil, it is used to abbreviate datatype IRIs as
+// in Turtle.
+func appendObject(b []byte, o Object, compact func(string) (string, bool)) []byte {
+	if o.IRI != "" {
+		return appendIRI(b, o.IRI)
+	}
+
+	b = append(b, '"')
+	for _, r := range o.Literal {
+		switch r {
+		case '"':
+			b = append(b, `\"`...)
+		case '\\':
+			b = append(b, `\\`...)
+		case '\n':
+			b = append(b, `\n`...)
+		case '\r':
+			b = append(b, `\r`...)
+		default:
+			if r < 0x20 || r == 0x7f {
+				b = appendUCHAR(b, r)
+				continue
+			}
+			b = utf8.AppendRune(b, r)
+		}
+	}
+	b = append(b, '"')
+
+	switch {
+	case o.Language != "":
+		b = append(b, '@')
+		b = append(b, o.Language...)
+	case o.Datatype != "":
+		b = append(b, "^^"...)
+		if compact != nil {
+			if qn, ok := compact(o.Datatype); ok {
+				return append(b, qn...)
+			}
+		}
+		b = appendIRI(b, o.Datatype)
+	}
+	return b
+}
+
+func appendUCHAR(b []byte, r rune) []byte {
+	if r > 0xffff {
+		return fmt.Appendf(b, `\U%08X`, r)
+	}
+	return fmt.Appendf(b, `\u%04X`, r)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/dcmi/dc"
+	"github.com/nagare-media/models.go/dcmi/dcterms"
+)
+
+const rdfSubject = "https://example.com/media/1"
+
+func rdfFixture() *dcterms.ElementsAndTerms {
+	return &dcterms.ElementsAndTerms{
+		Elements: dcterms.Elements{
+			DCTitle: []dc.Title{{Value: "Big Buck Bunny"}},
+		},
+		Terms: dcterms.Terms{
+			Title: []dcterms.Title{
+				{Value: "Big Buck Bunny", XMLLang: "en"},
+				{Value: "Großer Bock Hase", XMLLang: "de"},
+			},
+			Description: []dcterms.Description{{Value: "A \"big\" rabbit.\nThe end."}},
+			Created:     []dcterms.Created{{Value: "2008-05-20", XSIType: dcterms.W3CDTFXSIType}},
+			Extent:      []dcterms.Extent{{Value: "PT9M56S", XSIType: "xs:duration"}},
+		},
+	}
+}
+
+const rdfNTriples = `<https://example.com/media/1> <http://purl.org/dc/elements/1.1/title> "Big Buck Bunny" .
+<https://example.com/media/1> <http://purl.org/dc/terms/title> "Big Buck Bunny"@en .
+<https://example.com/media/1> <http://purl.org/dc/terms/title> "Großer Bock Hase"@de .
+<https://example.com/media/1> <http://purl.org/dc/terms/description> "A \"big\" rabbit.\nThe end." .
+<https://example.com/media/1> <http://purl.org/dc/terms/created> "2008-05-20"^^<http://purl.org/dc/terms/W3CDTF> .
+<https://example.com/media/1> <http://purl.org/dc/terms/extent> "PT9M56S"^^<http://www.w3.org/2001/XMLSchema#duration> .
+`
+
+const rdfTurtle = `@prefix dc: <http://purl.org/dc/elements/1.1/> .
+@prefix dcterms: <http://purl.org/dc/terms/> .
+@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .
+
+<https://example.com/media/1>
+    dc:title "Big Buck Bunny" ;
+    dcterms:title "Big Buck Bunny"@en, "Großer Bock Hase"@de ;
+    dcterms:description "A \"big\" rabbit.\nThe end." ;
+    dcterms:created "2008-05-20"^^dcterms:W3CDTF ;
+    dcterms:extent "PT9M56S"^^xsd:duration .
+`
+
+const rdfJSONLD = `{"@context":{"dc":"http://purl.org/dc/elements/1.1/","dcterms":"http://purl.org/dc/terms/",` +
+	`"xsd":"http://www.w3.org/2001/XMLSchema#"},"@id":"https://example.com/media/1",` +
+	`"dc:title":"Big Buck Bunny",` +
+	`"dcterms:created":{"@type":"dcterms:W3CDTF","@value":"2008-05-20"},` +
+	`"dcterms:description":"A \"big\" rabbit.\nThe end.",` +
+	`"dcterms:extent":{"@type":"xsd:duration","@value":"PT9M56S"},` +
+	`"dcterms:title":[{"@language":"en","@value":"Big Buck Bunny"},{"@language":"de","@value":"Großer Bock Hase"}]}`
+
+func TestMarshalRDF(t *testing.T) {
+	tests := []struct {
+		name    string
+		marshal func(string, any) ([]byte, error)
+		want    string
+	}{
+		{"N-Triples", dcterms.MarshalNTriples, rdfNTriples},
+		{"Turtle", dcterms.MarshalTurtle, rdfTurtle},
+		{"JSON-LD", dcterms.MarshalJSONLD, rdfJSONLD},
+	}
+
+	for _, tc := range tests {
+		got, err := tc.marshal(rdfSubject, rdfFixture())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.name, diff)
+		}
+	}
+}
+
+func TestUnmarshalRDF(t *testing.T) {
+	tests := []struct {
+		name      string
+		unmarshal func([]byte, any) (string, error)
+		data      string
+	}{
+		{"N-Triples", dcterms.UnmarshalNTriples, rdfNTriples},
+		{"JSON-LD", dcterms.UnmarshalJSONLD, rdfJSONLD},
+	}
+
+	for _, tc := range tests {
+		got := &dcterms.ElementsAndTerms{}
+		subject, err := tc.unmarshal([]byte(tc.data), got)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if subject != rdfSubject {
+			t.Errorf("%s: expected subject %q, got %q", tc.name, rdfSubject, subject)
+		}
+		if diff := cmp.Diff(rdfFixture(), got); diff != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.name, diff)
+		}
+	}
+}
+
+func TestUnmarshalJSONLDContext(t *testing.T) {
+	data := `{
+		"@context": [
+			{"dct": "http://purl.org/dc/terms/", "@language": "en"},
+			{
+				"name": "dct:title",
+				"created": {"@id": "dct:created", "@type": "dct:W3CDTF"},
+				"license": {"@id": "dct:license", "@type": "@id"},
+				"note": {"@id": "dct:description", "@language": null}
+			}
+		],
+		"@graph": [{
+			"@id": "dct:example",
+			"@type": "dct:BibliographicResource",
+			"name": ["Sintel", {"@value": "Sintel", "@language": "de"}],
+			"created": "2010",
+			"license": "https://creativecommons.org/licenses/by/3.0/",
+			"note": "Short film",
+			"dct:publisher": {"@id": "https://www.blender.org"},
+			"unmapped": "dropped"
+		}]
+	}`
+
+	got := &dcterms.Terms{}
+	subject, err := dcterms.UnmarshalJSONLD([]byte(data), got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if subject != "http://purl.org/dc/terms/example" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+	want := &dcterms.Terms{
+		Title:       []dcterms.Title{{Value: "Sintel", XMLLang: "en"}, {Value: "Sintel", XMLLang: "de"}},
+		Publisher:   []dcterms.Publisher{{Value: "https://www.blender.org", XSIType: dcterms.URIXSIType}},
+		Created:     []dcterms.Created{{Value: "2010", XSIType: dcterms.W3CDTFXSIType}},
+		Description: []dcterms.Description{{Value: "Short film"}},
+		License:     []dcterms.License{{Value: "https://creativecommons.org/licenses/by/3.0/", XSIType: dcterms.URIXSIType}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRDFErrors(t *testing.T) {
+	if _, err := dcterms.MarshalNTriples("relative/path", rdfFixture()); err == nil {
+		t.Error("relative subject: expected error")
+	}
+	if _, err := dcterms.MarshalTurtle(rdfSubject, dcterms.Terms{}); err == nil {
+		t.Error("non-pointer value: expected error")
+	}
+	both := &dcterms.Terms{Created: []dcterms.Created{{Value: "2010", XMLLang: "en", XSIType: dcterms.W3CDTFXSIType}}}
+	if _, err := dcterms.MarshalJSONLD(rdfSubject, both); err == nil {
+		t.Error("xml:lang and xsi:type: expected error")
+	}
+	unknown := &dcterms.Terms{Created: []dcterms.Created{{Value: "2010", XSIType: "custom:Year"}}}
+	if _, err := dcterms.MarshalNTriples(rdfSubject, unknown); err == nil {
+		t.Error("unknown xsi:type prefix: expected error")
+	}
+
+	for _, data := range []string{
+		`<https://example.com/a> <http://purl.org/dc/terms/title> "unterminated .`,
+		`<https://example.com/a> <http://purl.org/dc/terms/title> "x"`,
+		`<https://example.com/a> <http://purl.org/dc/terms/title> _:b0 .`,
+		`<https://example.com/a> <http://purl.org/dc/terms/title> "\q" .`,
+		`<https://example.com/a> <http://purl.org/dc/terms/title> "x"^^<http://example.com/dt> .`,
+		"<https://example.com/a> <http://purl.org/dc/terms/title> \"a\" .\n" +
+			"<https://example.com/b> <http://purl.org/dc/terms/title> \"b\" .",
+	} {
+		if _, err := dcterms.UnmarshalNTriples([]byte(data), &dcterms.Terms{}); err == nil {
+			t.Errorf("%q: expected error", data)
+		}
+	}
+
+	for _, data := range []string{
+		`{"@context": "https://schema.org/", "name": "x"}`,
+		`[{"@id": "https://example.com/a"}, {"@id": "https://example.com/b"}]`,
+		`{"http://purl.org/dc/terms/title": {"@list": ["x"]}}`,
+		`{"http://purl.org/dc/terms/creator": {"http://xmlns.com/foaf/0.1/name": "x"}}`,
+	} {
+		if _, err := dcterms.UnmarshalJSONLD([]byte(data), &dcterms.Terms{}); err == nil {
+			t.Errorf("%q: expected error", data)
+		}
+	}
+}
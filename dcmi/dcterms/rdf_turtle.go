@@ -0,0 +1,82 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarshalTurtle returns the Turtle representation of the RDF statements about subject described by v. Predicates and
+// datatypes are abbreviated with the prefixes "dc", "dcterms" and "xsd". See Triples for the supported types of v.
+//
+// See https://www.w3.org/TR/turtle/
+func MarshalTurtle(subject string, v any) ([]byte, error) {
+	ts, err := Triples(subject, v)
+	if err != nil {
+		return nil, fmt.Errorf("MarshalTurtle: %w", err)
+	}
+
+	used := make(map[string]bool)
+	compact := func(iri string) (string, bool) {
+		qn, ok := compactIRI(iri)
+		if !ok || !isLocalName(qn[strings.IndexByte(qn, ':')+1:]) {
+			return "", false
+		}
+		used[qn[:strings.IndexByte(qn, ':')]] = true
+		return qn, true
+	}
+
+	// an empty document represents the empty graph
+	if len(ts) == 0 {
+		return []byte{}, nil
+	}
+
+	var body []byte
+	body = appendNTriplesTerm(body, subject)
+	for i, t := range ts {
+		if i > 0 && t.Predicate == ts[i-1].Predicate {
+			body = append(body, ", "...)
+		} else {
+			if i > 0 {
+				body = append(body, " ;"...)
+			}
+			body = append(body, "\n    "...)
+			if qn, ok := compact(t.Predicate); ok {
+				body = append(body, qn...)
+			} else {
+				body = appendIRI(body, t.Predicate)
+			}
+			body = append(body, ' ')
+		}
+		body = appendObject(body, t.Object, compact)
+		if i == len(ts)-1 {
+			body = append(body, " .\n"...)
+		}
+	}
+
+	var b []byte
+	for _, p := range rdfPrefixes {
+		if used[p.prefix] {
+			b = fmt.Appendf(b, "@prefix %s: <%s> .\n", p.prefix, p.ns)
+		}
+	}
+	if len(b) > 0 {
+		b = append(b, '\n')
+	}
+	return append(b, body...), nil
+}
@@ -16,7 +16,13 @@ limitations under the License.
 
 package dcterms
 
-import "github.com/nagare-media/models.go/dcmi/dc"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/dcmi/dc"
+)
 
 const (
 	BoxXSIType      = "dcterms:Box"
@@ -51,12 +57,48 @@ type ISO3166 dc.SimpleLiteral
 // See http://purl.org/dc/terms/ISO639_2
 type ISO639_2 dc.SimpleLiteral
 
+// Language returns the ISO 639 registry entry of the ISO 639-2/B or ISO 639-2/T code.
+func (l *ISO639_2) Language() (base.ISO639Language, error) {
+	lang, ok := base.LookupISO639(l.Value)
+	if !ok || len(l.Value) != 3 || (!strings.EqualFold(lang.Part2B, l.Value) && !strings.EqualFold(lang.Part2T, l.Value)) {
+		return base.ISO639Language{}, fmt.Errorf("ISO639_2.Language: unknown ISO 639-2 code %q", l.Value)
+	}
+	return lang, nil
+}
+
+// LanguageTag returns the language as BCP 47 language tag.
+func (l *ISO639_2) LanguageTag() (base.LanguageTag, error) {
+	lang, err := l.Language()
+	if err != nil {
+		return "", err
+	}
+	return base.LanguageTag(lang.Shortest()), nil
+}
+
 // The set of three-letter codes listed in ISO 639-3 for the representation of names of languages.
 //
 // http://www.sil.org/iso639-3/
 // See http://purl.org/dc/terms/ISO639_3
 type ISO639_3 dc.SimpleLiteral
 
+// Language returns the ISO 639 registry entry of the ISO 639-3 code.
+func (l *ISO639_3) Language() (base.ISO639Language, error) {
+	lang, ok := base.LookupISO639(l.Value)
+	if !ok || !strings.EqualFold(lang.Part3, l.Value) {
+		return base.ISO639Language{}, fmt.Errorf("ISO639_3.Language: unknown ISO 639-3 code %q", l.Value)
+	}
+	return lang, nil
+}
+
+// LanguageTag returns the language as BCP 47 language tag.
+func (l *ISO639_3) LanguageTag() (base.LanguageTag, error) {
+	lang, err := l.Language()
+	if err != nil {
+		return "", err
+	}
+	return base.LanguageTag(lang.Shortest()), nil
+}
+
 // The set of time intervals defined by their limits according to the DCMI Period Encoding Scheme.
 //
 // https://www.dublincore.org/specifications/dublin-core/dcmi-period/
@@ -75,6 +117,11 @@ type Point dc.SimpleLiteral
 // See http://purl.org/dc/terms/RFC1766
 type RFC1766 dc.SimpleLiteral
 
+// LanguageTag returns the normalized BCP 47 language tag.
+func (l *RFC1766) LanguageTag() (base.LanguageTag, error) {
+	return base.ParseLanguageTag(l.Value)
+}
+
 // The set of tags constructed according to RFC 3066 for the identification of languages.
 //
 // RFC 3066 has been obsoleted by RFC 4646.
@@ -83,6 +130,11 @@ type RFC1766 dc.SimpleLiteral
 // See http://purl.org/dc/terms/RFC3066
 type RFC3066 dc.SimpleLiteral
 
+// LanguageTag returns the normalized BCP 47 language tag.
+func (l *RFC3066) LanguageTag() (base.LanguageTag, error) {
+	return base.ParseLanguageTag(l.Value)
+}
+
 // The set of tags constructed according to RFC 4646 for the identification of languages.
 //
 // RFC 4646 obsoletes RFC 3066.
@@ -91,6 +143,11 @@ type RFC3066 dc.SimpleLiteral
 // See http://purl.org/dc/terms/RFC4646
 type RFC4646 dc.SimpleLiteral
 
+// LanguageTag returns the normalized BCP 47 language tag.
+func (l *RFC4646) LanguageTag() (base.LanguageTag, error) {
+	return base.ParseLanguageTag(l.Value)
+}
+
 // The set of tags constructed according to RFC 5646 for the identification of languages.
 //
 // RFC 5646 obsoletes RFC 4646.
@@ -101,6 +158,11 @@ type RFC4646 dc.SimpleLiteral
 // TODO: this is not specified in the XML schema
 type RFC5646 dc.SimpleLiteral
 
+// LanguageTag returns the normalized BCP 47 language tag.
+func (l *RFC5646) LanguageTag() (base.LanguageTag, error) {
+	return base.ParseLanguageTag(l.Value)
+}
+
 // The set of identifiers constructed according to the generic syntax for Uniform Resource Identifiers as specified by
 // the Internet Engineering Task Force.
 //
@@ -0,0 +1,180 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/dcmi/dc"
+	"github.com/nagare-media/models.go/dcmi/dcmitype"
+)
+
+func init() {
+	dc.RegisterXSIType(BoxXSIType, func(s string) (any, error) { return ParseBox(s) })
+	dc.RegisterXSIType(PeriodXSIType, func(s string) (any, error) { return ParsePeriod(s) })
+	dc.RegisterXSIType(PointXSIType, func(s string) (any, error) { return ParsePoint(s) })
+	dc.RegisterXSIType(W3CDTFXSIType, func(s string) (any, error) { return base.ParseDateTime(s) })
+	dc.RegisterXSIType(URIXSIType, parseURI)
+	dc.RegisterXSIType(ISO3166XSIType, parseISO3166)
+	dc.RegisterXSIType(ISO639_2XSIType, func(s string) (any, error) { return (&ISO639_2{Value: s}).Language() })
+	dc.RegisterXSIType(ISO639_3XSIType, func(s string) (any, error) { return (&ISO639_3{Value: s}).Language() })
+	for _, t := range []string{RFC1766XSIType, RFC3066XSIType, RFC4646XSIType, RFC5646XSIType} {
+		dc.RegisterXSIType(t, func(s string) (any, error) { return base.ParseLanguageTag(s) })
+	}
+
+	dc.RegisterXSIType(DCMITypeXSIType, parseDCMIType)
+	dc.RegisterXSIType(IMTXSIType, parseIMT)
+	// classification schemes and subject headings are kept as string
+	for _, t := range []string{DDCXSIType, LCCXSIType, LCSHXSIType, MESHXSIType, NLMXSIType, TGNXSIType, UDCXSIType} {
+		dc.RegisterXSIType(t, parseString)
+	}
+}
+
+func parseString(s string) (any, error) {
+	return s, nil
+}
+
+func parseURI(s string) (any, error) {
+	u := base.URI(s)
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// parseISO3166 checks the syntax of ISO 3166-1 alpha-2, alpha-3 and numeric codes and returns them in upper case.
+func parseISO3166(s string) (any, error) {
+	alpha, numeric := true, true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		alpha = alpha && (('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z'))
+		numeric = numeric && '0' <= c && c <= '9'
+	}
+	if !(alpha && (len(s) == 2 || len(s) == 3)) && !(numeric && len(s) == 3) {
+		return nil, errors.New("not an ISO 3166-1 code")
+	}
+	return strings.ToUpper(s), nil
+}
+
+func parseDCMIType(s string) (any, error) {
+	return dcmitype.Parse(s)
+}
+
+// parseIMT validates a media type and returns it in normalized form.
+func parseIMT(s string) (any, error) {
+	mt, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(mt, "/") {
+		return nil, fmt.Errorf("media type %q without subtype", mt)
+	}
+	return mime.FormatMediaType(mt, params), nil
+}
+
+// typedAs parses the value of an encoding scheme literal. The xsi:type of the literal takes precedence over the
+// xsi:type of the encoding scheme.
+func typedAs(l *dc.SimpleLiteral, xsiType string) (any, error) {
+	if l.XSIType != "" {
+		xsiType = l.XSIType
+	}
+	return dc.ParseXSIType(xsiType, l.Value)
+}
+
+// Typed returns the value parsed according to its xsi:type (see dc.SimpleLiteral.Typed).
+func (l *Title) Typed() (any, error)                 { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Creator) Typed() (any, error)               { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Subject) Typed() (any, error)               { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Description) Typed() (any, error)           { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Publisher) Typed() (any, error)             { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Contributor) Typed() (any, error)           { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Date) Typed() (any, error)                  { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Type) Typed() (any, error)                  { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Format) Typed() (any, error)                { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Identifier) Typed() (any, error)            { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Source) Typed() (any, error)                { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Language) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Relation) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Coverage) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Rights) Typed() (any, error)                { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Alternative) Typed() (any, error)           { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *TableOfContents) Typed() (any, error)       { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Abstract) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Created) Typed() (any, error)               { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Valid) Typed() (any, error)                 { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Available) Typed() (any, error)             { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Issued) Typed() (any, error)                { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Modified) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *DateAccepted) Typed() (any, error)          { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *DateCopyrighted) Typed() (any, error)       { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *DateSubmitted) Typed() (any, error)         { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Extent) Typed() (any, error)                { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Medium) Typed() (any, error)                { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *IsVersionOf) Typed() (any, error)           { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *HasVersion) Typed() (any, error)            { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *IsReplacedBy) Typed() (any, error)          { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Replaces) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *IsRequiredBy) Typed() (any, error)          { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Requires) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *IsPartOf) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *HasPart) Typed() (any, error)               { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *IsReferencedBy) Typed() (any, error)        { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *References) Typed() (any, error)            { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *IsFormatOf) Typed() (any, error)            { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *HasFormat) Typed() (any, error)             { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *ConformsTo) Typed() (any, error)            { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Spatial) Typed() (any, error)               { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Temporal) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Audience) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *AccrualMethod) Typed() (any, error)         { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *AccrualPeriodicity) Typed() (any, error)    { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *AccrualPolicy) Typed() (any, error)         { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *InstructionalMethod) Typed() (any, error)   { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Provenance) Typed() (any, error)            { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *RightsHolder) Typed() (any, error)          { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *Mediator) Typed() (any, error)              { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *EducationLevel) Typed() (any, error)        { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *AccessRights) Typed() (any, error)          { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *License) Typed() (any, error)               { return (*dc.SimpleLiteral)(l).Typed() }
+func (l *BibliographicCitation) Typed() (any, error) { return (*dc.SimpleLiteral)(l).Typed() }
+
+// Typed returns the value parsed according to the encoding scheme (see dc.SimpleLiteral.Typed).
+func (l *Box) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), BoxXSIType) }
+func (l *ISO3166) Typed() (any, error)  { return typedAs((*dc.SimpleLiteral)(l), ISO3166XSIType) }
+func (l *ISO639_2) Typed() (any, error) { return typedAs((*dc.SimpleLiteral)(l), ISO639_2XSIType) }
+func (l *ISO639_3) Typed() (any, error) { return typedAs((*dc.SimpleLiteral)(l), ISO639_3XSIType) }
+func (l *Period) Typed() (any, error)   { return typedAs((*dc.SimpleLiteral)(l), PeriodXSIType) }
+func (l *Point) Typed() (any, error)    { return typedAs((*dc.SimpleLiteral)(l), PointXSIType) }
+func (l *RFC1766) Typed() (any, error)  { return typedAs((*dc.SimpleLiteral)(l), RFC1766XSIType) }
+func (l *RFC3066) Typed() (any, error)  { return typedAs((*dc.SimpleLiteral)(l), RFC3066XSIType) }
+func (l *RFC4646) Typed() (any, error)  { return typedAs((*dc.SimpleLiteral)(l), RFC4646XSIType) }
+func (l *RFC5646) Typed() (any, error)  { return typedAs((*dc.SimpleLiteral)(l), RFC5646XSIType) }
+func (l *URI) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), URIXSIType) }
+func (l *W3CDTF) Typed() (any, error)   { return typedAs((*dc.SimpleLiteral)(l), W3CDTFXSIType) }
+func (l *DCMIType) Typed() (any, error) { return typedAs((*dc.SimpleLiteral)(l), DCMITypeXSIType) }
+func (l *DDC) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), DDCXSIType) }
+func (l *IMT) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), IMTXSIType) }
+func (l *LCC) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), LCCXSIType) }
+func (l *LCSH) Typed() (any, error)     { return typedAs((*dc.SimpleLiteral)(l), LCSHXSIType) }
+func (l *MESH) Typed() (any, error)     { return typedAs((*dc.SimpleLiteral)(l), MESHXSIType) }
+func (l *NLM) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), NLMXSIType) }
+func (l *TGN) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), TGNXSIType) }
+func (l *UDC) Typed() (any, error)      { return typedAs((*dc.SimpleLiteral)(l), UDCXSIType) }
@@ -0,0 +1,89 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dcterms_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/base"
+	"github.com/nagare-media/models.go/dcmi/dc"
+	"github.com/nagare-media/models.go/dcmi/dcmitype"
+	"github.com/nagare-media/models.go/dcmi/dcterms"
+)
+
+func TestTypedXSIType(t *testing.T) {
+	tests := []struct {
+		name string
+		lit  interface{ Typed() (any, error) }
+		want any
+	}{
+		{"created", &dcterms.Created{Value: "2021-05-03T10:00Z", XSIType: dcterms.W3CDTFXSIType},
+			base.DateTime{Time: time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC), Precision: base.MinutePrecision}},
+		{"language ISO639-2", &dcterms.Language{Value: "ger", XSIType: dcterms.ISO639_2XSIType},
+			base.ISO639Language{Part3: "deu", Part2B: "ger", Part2T: "deu", Part1: "de", Scope: base.IndividualISO639Scope, Name: "German"}},
+		{"language ISO639-2 upper case", &dcterms.Language{Value: "DEU", XSIType: dcterms.ISO639_2XSIType},
+			base.ISO639Language{Part3: "deu", Part2B: "ger", Part2T: "deu", Part1: "de", Scope: base.IndividualISO639Scope, Name: "German"}},
+		{"language ISO639-3 upper case", &dcterms.Language{Value: "GSW", XSIType: dcterms.ISO639_3XSIType},
+			base.ISO639Language{Part3: "gsw", Part2B: "gsw", Part2T: "gsw", Scope: base.IndividualISO639Scope, Name: "Swiss German"}},
+		{"language RFC5646", &dc.Language{Value: "en-us", XSIType: dcterms.RFC5646XSIType}, base.LanguageTag("en-US")},
+		{"identifier", &dcterms.Identifier{Value: "urn:isbn:978-3-16-148410-0", XSIType: dcterms.URIXSIType},
+			base.URI("urn:isbn:978-3-16-148410-0")},
+		{"type", &dcterms.Type{Value: "MovingImage", XSIType: dcterms.DCMITypeXSIType}, dcmitype.MovingImage},
+		{"format", &dcterms.Format{Value: "Video/MP4", XSIType: dcterms.IMTXSIType}, "video/mp4"},
+		{"spatial ISO3166", &dcterms.Spatial{Value: "de", XSIType: dcterms.ISO3166XSIType}, "DE"},
+		{"subject LCSH", &dcterms.Subject{Value: "Motion pictures", XSIType: dcterms.LCSHXSIType}, "Motion pictures"},
+		{"untyped", &dcterms.Title{Value: "A title"}, "A title"},
+		{"encoding scheme", &dcterms.W3CDTF{Value: "2021"},
+			base.DateTime{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), Precision: base.YearPrecision}},
+	}
+
+	for _, tc := range tests {
+		got, err := tc.lit.Typed()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.name, diff)
+		}
+	}
+}
+
+func TestTypedXSITypeErrors(t *testing.T) {
+	for _, lit := range []interface{ Typed() (any, error) }{
+		&dcterms.Created{Value: "yesterday", XSIType: dcterms.W3CDTFXSIType},
+		&dcterms.Language{Value: "xyz", XSIType: dcterms.ISO639_2XSIType},
+		&dcterms.Language{Value: "DE", XSIType: dcterms.ISO639_2XSIType},
+		&dcterms.Language{Value: "deu", XSIType: dcterms.ISO639_3XSIType + "x"},
+		&dcterms.Identifier{Value: "http://nagare media", XSIType: dcterms.URIXSIType},
+		&dcterms.Type{Value: "Movie", XSIType: dcterms.DCMITypeXSIType},
+		&dcterms.Format{Value: "mp4", XSIType: dcterms.IMTXSIType},
+	} {
+		if _, err := lit.Typed(); err == nil {
+			t.Errorf("%+v: expected error", lit)
+		}
+	}
+
+	l := dc.SimpleLiteral{Value: "x", XSIType: "custom:Type"}
+	if _, err := l.Typed(); !errors.Is(err, dc.ErrUnknownXSIType) {
+		t.Errorf("expected ErrUnknownXSIType, got %v", err)
+	}
+}
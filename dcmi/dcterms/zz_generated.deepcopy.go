@@ -174,6 +174,31 @@ func (in *Box) DeepCopy() *Box {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BoxValue) DeepCopyInto(out *BoxValue) {
+	*out = *in
+	if in.UpLimit != nil {
+		in, out := &in.UpLimit, &out.UpLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.DownLimit != nil {
+		in, out := &in.DownLimit, &out.DownLimit
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BoxValue.
+func (in *BoxValue) DeepCopy() *BoxValue {
+	if in == nil {
+		return nil
+	}
+	out := new(BoxValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConformsTo) DeepCopyInto(out *ConformsTo) {
 	*out = *in
@@ -478,6 +503,31 @@ func (in *ElementsAndTerms) DeepCopy() *ElementsAndTerms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElementsReport) DeepCopyInto(out *ElementsReport) {
+	*out = *in
+	if in.Folded != nil {
+		in, out := &in.Folded, &out.Folded
+		*out = make([]TermValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.Dropped != nil {
+		in, out := &in.Dropped, &out.Dropped
+		*out = make([]TermValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElementsReport.
+func (in *ElementsReport) DeepCopy() *ElementsReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ElementsReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Extent) DeepCopyInto(out *Extent) {
 	*out = *in
@@ -883,6 +933,21 @@ func (in *NLM) DeepCopy() *NLM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Object) DeepCopyInto(out *Object) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Object.
+func (in *Object) DeepCopy() *Object {
+	if in == nil {
+		return nil
+	}
+	out := new(Object)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Period) DeepCopyInto(out *Period) {
 	*out = *in
@@ -898,6 +963,21 @@ func (in *Period) DeepCopy() *Period {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeriodValue) DeepCopyInto(out *PeriodValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeriodValue.
+func (in *PeriodValue) DeepCopy() *PeriodValue {
+	if in == nil {
+		return nil
+	}
+	out := new(PeriodValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Point) DeepCopyInto(out *Point) {
 	*out = *in
@@ -913,6 +993,26 @@ func (in *Point) DeepCopy() *Point {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PointValue) DeepCopyInto(out *PointValue) {
+	*out = *in
+	if in.Elevation != nil {
+		in, out := &in.Elevation, &out.Elevation
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointValue.
+func (in *PointValue) DeepCopy() *PointValue {
+	if in == nil {
+		return nil
+	}
+	out := new(PointValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provenance) DeepCopyInto(out *Provenance) {
 	*out = *in
@@ -1183,6 +1283,22 @@ func (in *Temporal) DeepCopy() *Temporal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TermValue) DeepCopyInto(out *TermValue) {
+	*out = *in
+	out.Value = in.Value
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TermValue.
+func (in *TermValue) DeepCopy() *TermValue {
+	if in == nil {
+		return nil
+	}
+	out := new(TermValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Terms) DeepCopyInto(out *Terms) {
 	*out = *in
@@ -1489,6 +1605,22 @@ func (in *Title) DeepCopy() *Title {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Triple) DeepCopyInto(out *Triple) {
+	*out = *in
+	out.Object = in.Object
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Triple.
+func (in *Triple) DeepCopy() *Triple {
+	if in == nil {
+		return nil
+	}
+	out := new(Triple)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Type) DeepCopyInto(out *Type) {
 	*out = *in
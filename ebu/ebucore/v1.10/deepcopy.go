@@ -0,0 +1,114 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_10
+
+import (
+	"github.com/nagare-media/models.go/internal/deepcopy"
+)
+
+// Hand-written DeepCopyInto for types with pointers to arrays, which the generator cannot copy.
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Format) DeepCopyInto(out *Format) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	in.FileInfo.DeepCopyInto(&out.FileInfo)
+	out.DCFormat = in.DCFormat.DeepCopy()
+	out.Medium = deepcopy.Slice(in.Medium)
+	out.ImageFormat = deepcopy.Slice(in.ImageFormat)
+	out.VideoFormat = deepcopy.Slice(in.VideoFormat)
+	out.AudioFormat = deepcopy.Slice(in.AudioFormat)
+	out.AudioFormatExtended = deepcopy.Slice(in.AudioFormatExtended)
+	out.ContainerFormat = deepcopy.Slice(in.ContainerFormat)
+	out.SigningFormat = in.SigningFormat.DeepCopy()
+	out.DataFormat = deepcopy.Slice(in.DataFormat)
+	out.TimecodeFormat = deepcopy.Slice(in.TimecodeFormat)
+	out.MetadataFormat = deepcopy.Slice(in.MetadataFormat)
+	if in.AcquisitionData != nil {
+		out.AcquisitionData = new([2]*AcquisitionData)
+		for i, v := range in.AcquisitionData {
+			out.AcquisitionData[i] = v.DeepCopy()
+		}
+	}
+	out.HDRMetadata = in.HDRMetadata.DeepCopy()
+	out.Start = deepcopy.Slice(in.Start)
+	out.End = deepcopy.Slice(in.End)
+	out.Duration = deepcopy.Slice(in.Duration)
+	out.DocumentFormat = in.DocumentFormat.DeepCopy()
+	out.DateCreated = in.DateCreated.DeepCopy()
+	out.DateModified = in.DateModified.DeepCopy()
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioObjectInteraction) DeepCopyInto(out *AudioObjectInteraction) {
+	*out = *in
+	if in.GainInteractionRange != nil {
+		out.GainInteractionRange = new([2]*GainInteractionRange)
+		for i, v := range in.GainInteractionRange {
+			out.GainInteractionRange[i] = v.DeepCopy()
+		}
+	}
+	if in.PositionInteractionRange != nil {
+		out.PositionInteractionRange = new([6]*PositionInteractionRange)
+		for i, v := range in.PositionInteractionRange {
+			out.PositionInteractionRange[i] = v.DeepCopy()
+		}
+	}
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioChannelFormat) DeepCopyInto(out *AudioChannelFormat) {
+	*out = *in
+	if in.Frequency != nil {
+		out.Frequency = new([2]*Frequency)
+		for i, v := range in.Frequency {
+			out.Frequency[i] = v.DeepCopy()
+		}
+	}
+	out.AudioBlockFormat = deepcopy.Slice(in.AudioBlockFormat)
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioBlockFormat) DeepCopyInto(out *AudioBlockFormat) {
+	*out = *in
+	out.SpeakerLabel = deepcopy.Slice(in.SpeakerLabel)
+	if in.Position != nil {
+		out.Position = new([3]*AudioBlockFormatPosition)
+		for i, v := range in.Position {
+			out.Position[i] = v.DeepCopy()
+		}
+	}
+	out.Matrix = in.Matrix.DeepCopy()
+	out.OutputChannelIDRef = in.OutputChannelIDRef.DeepCopy()
+	out.Cartesian = in.Cartesian.DeepCopy()
+	out.Gain = in.Gain.DeepCopy()
+	out.Diffuse = in.Diffuse.DeepCopy()
+	out.Width = in.Width.DeepCopy()
+	out.Height = in.Height.DeepCopy()
+	out.Depth = in.Depth.DeepCopy()
+	out.ChannelLock = in.ChannelLock.DeepCopy()
+	out.ObjectDivergence = in.ObjectDivergence.DeepCopy()
+	out.JumpPosition = in.JumpPosition.DeepCopy()
+	out.ZoneExclusion = in.ZoneExclusion.DeepCopy()
+	out.Importance = in.Importance.DeepCopy()
+	out.Degree = in.Degree.DeepCopy()
+	out.Order = in.Order.DeepCopy()
+	out.Equation = in.Equation.DeepCopy()
+	out.NfcRefDist = in.NfcRefDist.DeepCopy()
+	out.Normalization = in.Normalization.DeepCopy()
+	out.ScreenRef = in.ScreenRef.DeepCopy()
+}
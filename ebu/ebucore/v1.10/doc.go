@@ -19,4 +19,6 @@ limitations under the License.
 //
 // See https://tech.ebu.ch/MetadataEbuCore
 // See http://www.ebu.ch/metadata/schemas/EBUCore/20200805/ebucore.xsd
+//
+// +kubebuilder:object:generate=true
 package v1_10
@@ -17,6 +17,8 @@ limitations under the License.
 package v1_10
 
 import (
+	"time"
+
 	"github.com/nagare-media/models.go/base"
 	"github.com/nagare-media/models.go/dcmi/dc"
 	"github.com/nagare-media/models.go/third_party/encoding/xml"
@@ -65,7 +67,7 @@ type Main struct {
 
 	// An attribute to specify the dominant language used to express metadata information in the document, which can be
 	// superceded each time an language attribute or element is available a different levels of description granularity
-	XMLLang string `xml:"xml:lang,attr,omitempty" json:"@xml:lang,omitempty"`
+	XMLLang base.LanguageTag `xml:"xml:lang,attr,omitempty" json:"@xml:lang,omitempty"`
 
 	// To provide information on the name of a tool used to generate data
 	WritingLibraryName string `xml:"writingLibraryName,attr,omitempty" json:"@writingLibraryName,omitempty"`
@@ -466,7 +468,7 @@ type TextLine struct {
 	Order string `xml:"order,attr,omitempty" json:"@order,omitempty"`
 
 	// The language used for the text line
-	XMLLang string `xml:"xml:lang,attr,omitempty" json:"@xml:lang,omitempty"`
+	XMLLang base.LanguageTag `xml:"xml:lang,attr,omitempty" json:"@xml:lang,omitempty"`
 }
 
 type TextLineBoxartefacttyPosition struct {
@@ -1809,7 +1811,7 @@ type SigningFormat struct {
 	SigningSourceURI base.URI `xml:"signingSourceUri,attr,omitempty" json:"@signingSourceUri,omitempty"`
 
 	// To providing information on the signing language.
-	Language string `xml:"language,attr,omitempty" json:"@language,omitempty"`
+	Language base.LanguageTag `xml:"language,attr,omitempty" json:"@language,omitempty"`
 
 	// A flag to signal if signing is present.
 	SigningPresenceFlag bool `xml:"signingPresenceFlag,attr,omitempty" json:"@signingPresenceFlag,omitempty"`
@@ -2418,7 +2420,7 @@ type TypeAttributes struct {
 	TypeNamespace string `xml:"typeNamespace,attr,omitempty" json:"@typeNamespace,omitempty"`
 
 	// To define the language in which the type information is provided.
-	TypeLanguage string `xml:"typeLanguage,attr,omitempty" json:"@typeLanguage,omitempty"`
+	TypeLanguage base.LanguageTag `xml:"typeLanguage,attr,omitempty" json:"@typeLanguage,omitempty"`
 
 	// To define the controlled vocabulary where this term is coming from.
 	TypeThesaurus string `xml:"typeThesaurus,attr,omitempty" json:"@typeThesaurus,omitempty"`
@@ -2440,7 +2442,7 @@ type FormatAttributes struct {
 	FormatNamespace string `xml:"formatNamespace,attr,omitempty" json:"@formatNamespace,omitempty"`
 
 	// To define the language in which the type information is provided.
-	FormatLanguage string `xml:"formatLanguage,attr,omitempty" json:"@formatLanguage,omitempty"`
+	FormatLanguage base.LanguageTag `xml:"formatLanguage,attr,omitempty" json:"@formatLanguage,omitempty"`
 
 	// To define the controlled vocabulary where this term is coming from.
 	FormatThesaurus string `xml:"formatThesaurus,attr,omitempty" json:"@formatThesaurus,omitempty"`
@@ -2462,7 +2464,7 @@ type StatusAttributes struct {
 	StatusNamespace string `xml:"statusNamespace,attr,omitempty" json:"@statusNamespace,omitempty"`
 
 	// To define the language in which the type information is provided.
-	StatusLanguage string `xml:"statusLanguage,attr,omitempty" json:"@statusLanguage,omitempty"`
+	StatusLanguage base.LanguageTag `xml:"statusLanguage,attr,omitempty" json:"@statusLanguage,omitempty"`
 
 	// To define the controlled vocabulary where this term is coming from.
 	StatusThesaurus string `xml:"statusThesaurus,attr,omitempty" json:"@statusThesaurus,omitempty"`
@@ -2871,7 +2873,7 @@ type AudioTrack struct {
 	TypeAttributes
 
 	// The track language.
-	TrackLanguage string `xml:"trackLanguage,attr,omitempty" json:"@trackLanguage,omitempty"`
+	TrackLanguage base.LanguageTag `xml:"trackLanguage,attr,omitempty" json:"@trackLanguage,omitempty"`
 
 	// The track number.
 	TrackID string `xml:"trackId,attr,omitempty" json:"@trackId,omitempty"`
@@ -2921,7 +2923,7 @@ type DataFormat struct {
 	DataTrackName string `xml:"dataTrackName,attr,omitempty" json:"@dataTrackName,omitempty"`
 
 	// A group of attributes to specify the data language.
-	DataTrackLanguage string `xml:"dataTrackLanguage,attr,omitempty" json:"@dataTrackLanguage,omitempty"`
+	DataTrackLanguage base.LanguageTag `xml:"dataTrackLanguage,attr,omitempty" json:"@dataTrackLanguage,omitempty"`
 
 	// To provide additional information on a particular format profile
 	DataFormatProfile string `xml:"dataFormatProfile,attr,omitempty" json:"@dataFormatProfile,omitempty"`
@@ -2985,7 +2987,7 @@ type SubtitlingFormat struct {
 	SubtitlingSourceURI base.URI `xml:"subtitlingSourceUri,attr,omitempty" json:"@subtitlingSourceUri,omitempty"`
 
 	// The language used for subtitling.
-	Language string `xml:"language,attr,omitempty" json:"@language,omitempty"`
+	Language base.LanguageTag `xml:"language,attr,omitempty" json:"@language,omitempty"`
 
 	// Closed subtitling if true
 	Closed bool `xml:"closed,attr,omitempty" json:"@closed,omitempty"`
@@ -3021,7 +3023,7 @@ type CaptioningFormat struct {
 	CaptioningSourceURI base.URI `xml:"captioningSourceUri,attr,omitempty" json:"@captioningSourceUri,omitempty"`
 
 	// A group of attributes to specify the captioning language.
-	Language string `xml:"language,attr,omitempty" json:"@language,omitempty"`
+	Language base.LanguageTag `xml:"language,attr,omitempty" json:"@language,omitempty"`
 
 	// Closed captioning if true
 	Closed bool `xml:"closed,attr,omitempty" json:"@closed,omitempty"`
@@ -3333,7 +3335,7 @@ type AudioProgramme struct {
 	AudioProgrammeName string `xml:"audioProgrammeName,attr,omitempty" json:"@audioProgrammeName,omitempty"`
 
 	// The dialogue language used in this audioProgramme.
-	AudioProgrammeLanguage string `xml:"audioProgrammeLanguage,attr,omitempty" json:"@audioProgrammeLanguage,omitempty"`
+	AudioProgrammeLanguage base.LanguageTag `xml:"audioProgrammeLanguage,attr,omitempty" json:"@audioProgrammeLanguage,omitempty"`
 
 	// Start time for the programme.
 	Start TimecodeStringFrame `xml:"start,attr,omitempty" json:"@start,omitempty"`
@@ -3425,7 +3427,7 @@ type AudioContent struct {
 	AudioContentName string `xml:"audioContentName,attr,omitempty" json:"@audioContentName,omitempty"`
 
 	// The dialogue language used in this audioContent.
-	AudioContentLanguage string `xml:"audioContentLanguage,attr,omitempty" json:"@audioContentLanguage,omitempty"`
+	AudioContentLanguage base.LanguageTag `xml:"audioContentLanguage,attr,omitempty" json:"@audioContentLanguage,omitempty"`
 }
 
 type DialogueType struct {
@@ -3669,7 +3671,7 @@ type AudioPackFormat struct {
 	TypeNamespace string `xml:"typeNamespace,attr,omitempty" json:"@typeNamespace,omitempty"`
 
 	// To define the language in which the type information is provided.
-	TypeLanguage string `xml:"typeLanguage,attr,omitempty" json:"@typeLanguage,omitempty"`
+	TypeLanguage base.LanguageTag `xml:"typeLanguage,attr,omitempty" json:"@typeLanguage,omitempty"`
 
 	// To define the controlled vocabulary where this term is coming from.
 	TypeThesaurus string `xml:"typeThesaurus,attr,omitempty" json:"@typeThesaurus,omitempty"`
@@ -3741,7 +3743,7 @@ type AudioChannelFormat struct {
 	TypeNamespace string `xml:"typeNamespace,attr,omitempty" json:"@typeNamespace,omitempty"`
 
 	// To define the language in which the type information is provided.
-	TypeLanguage string `xml:"typeLanguage,attr,omitempty" json:"@typeLanguage,omitempty"`
+	TypeLanguage base.LanguageTag `xml:"typeLanguage,attr,omitempty" json:"@typeLanguage,omitempty"`
 
 	// To define the controlled vocabulary where this term is coming from.
 	TypeThesaurus string `xml:"typeThesaurus,attr,omitempty" json:"@typeThesaurus,omitempty"`
@@ -4173,6 +4175,47 @@ type Timecode struct {
 	FactorDenominator int `xml:"factorDenominator,attr,omitempty" json:"@factorDenominator,omitempty"`
 }
 
+// Rate returns the frame rate given by the editRate and correcting factor attributes.
+func (t *Timecode) Rate() base.TimecodeRate {
+	return base.TimecodeRate{
+		EditRate:          t.EditRate,
+		FactorNumerator:   t.FactorNumerator,
+		FactorDenominator: t.FactorDenominator,
+	}
+}
+
+// Timecode parses the timecode value. The timecode is drop-frame if either the dropframe attribute is set or the value
+// uses a drop-frame separator.
+func (t *Timecode) Timecode() (base.Timecode, error) {
+	tc, err := base.ParseTimecode(string(t.Value))
+	if err != nil {
+		return base.Timecode{}, err
+	}
+	tc.DropFrame = tc.DropFrame || t.Dropframe
+	if err = tc.Validate(t.Rate()); err != nil {
+		return base.Timecode{}, err
+	}
+	return tc, nil
+}
+
+// Duration returns the offset of the timecode from 00:00:00:00.
+func (t *Timecode) Duration() (time.Duration, error) {
+	tc, err := t.Timecode()
+	if err != nil {
+		return 0, err
+	}
+	return tc.Duration(t.Rate())
+}
+
+// SetTimecode sets value and attributes from tc counted in rate.
+func (t *Timecode) SetTimecode(tc base.Timecode, rate base.TimecodeRate) {
+	t.Value = TimecodeString(tc.String())
+	t.EditRate = rate.EditRate
+	t.Dropframe = tc.DropFrame
+	t.FactorNumerator = rate.FactorNumerator
+	t.FactorDenominator = rate.FactorDenominator
+}
+
 type TimecodeFrame struct {
 	Value TimecodeStringFrame `xml:",chardata" json:"#value"`
 
@@ -4389,6 +4432,33 @@ type Rational struct {
 	Unit string `xml:"unit,attr,omitempty" json:"@unit,omitempty"`
 }
 
+// Rational returns the value multiplied by the correcting factor. It returns base.ErrRationalOverflow if the result
+// does not fit into base.Rational.
+func (r *Rational) Rational() (base.Rational, error) {
+	num, den := int64(r.FactorNumerator), int64(r.FactorDenominator)
+	if num == 0 {
+		num = 1
+	}
+	if den == 0 {
+		den = 1
+	}
+	return base.Rational{Num: r.Value, Den: 1}.CheckedMul(base.Rational{Num: num, Den: den})
+}
+
+// SetRational sets value and correcting factor to v. Rates with denominator 1001 are expressed as nominal rate with a
+// correcting factor of 1000/1001, e.g. 30000/1001 as 30 * 1000/1001.
+func (r *Rational) SetRational(v base.Rational) {
+	v = v.Reduce()
+	switch {
+	case v.Den == 1:
+		r.Value, r.FactorNumerator, r.FactorDenominator = v.Num, 1, 1
+	case v.Den == 1001 && v.Num%1000 == 0:
+		r.Value, r.FactorNumerator, r.FactorDenominator = v.Num/1000, 1000, 1001
+	default:
+		r.Value, r.FactorNumerator, r.FactorDenominator = 1, int(v.Num), int(v.Den)
+	}
+}
+
 // A string to define e.g. the ratio of the picture (the width by the height), for instance '4:3' or '16:9' (rational).
 type AspectRatio struct {
 	// The type of aspect ratio.
@@ -4403,6 +4473,22 @@ type AspectRatio struct {
 	FactorDenominator Int `xml:"urn:ebu:metadata-schema:ebucore ebucore:factorDenominator,omitempty" json:"ebucore:factorDenominator,omitempty"`
 }
 
+// Rational returns the aspect ratio as rational. A missing denominator is interpreted as 1.
+func (ar *AspectRatio) Rational() base.Rational {
+	den := int64(ar.FactorDenominator.Value)
+	if den == 0 {
+		den = 1
+	}
+	return base.NewRational(int64(ar.FactorNumerator.Value), den)
+}
+
+// SetRational sets numerator and denominator to the reduced v.
+func (ar *AspectRatio) SetRational(v base.Rational) {
+	v = v.Reduce()
+	ar.FactorNumerator.Value = int(v.Num)
+	ar.FactorDenominator.Value = int(v.Den)
+}
+
 // A complex Type defining the structure of a technical attribute ot type rational.
 type TechnicalAttributeRational struct {
 	Rational
@@ -18,14 +18,17 @@ package v1_10_test
 
 import (
 	"encoding/json"
+	"errors"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/nagare-media/models.go/base"
 	"github.com/nagare-media/models.go/dcmi/dc"
 	ebucore "github.com/nagare-media/models.go/ebu/ebucore/v1.10"
 	"github.com/nagare-media/models.go/third_party/encoding/xml"
-	"github.com/senseyeio/duration"
 )
 
 var (
@@ -40,7 +43,7 @@ var (
 
 		CoreMetadata: ebucore.CoreMetadata{
 			Format: []ebucore.Format{{
-				Duration: []ebucore.Duration{{NormalPlayTime: &ebucore.DurationValue{Value: base.Duration{Duration: duration.Duration{TM: 12, TS: 4}}}}},
+				Duration: []ebucore.Duration{{NormalPlayTime: &ebucore.DurationValue{Value: base.Duration{Minutes: 12, Seconds: 4}}}},
 
 				ContainerFormat: []ebucore.ContainerFormat{{
 					ContainerFormatName: "MPEG-4",
@@ -222,3 +225,70 @@ func TestMarshalJSON(t *testing.T) {
 	// TODO: check output
 	_ = str
 }
+
+func TestTimecodeDuration(t *testing.T) {
+	tc := ebucore.Timecode{
+		Value:             "00:01:00:02",
+		EditRate:          30,
+		Dropframe:         true,
+		FactorNumerator:   1000,
+		FactorDenominator: 1001,
+	}
+	d, err := tc.Duration()
+	if err != nil || d != 60060*time.Millisecond {
+		t.Errorf("Duration() = %s (%v); want 1m0.06s", d, err)
+	}
+}
+
+func TestRationalRoundTrip(t *testing.T) {
+	var r ebucore.Rational
+	r.SetRational(base.Rate29_97)
+	if r.Value != 30 || r.FactorNumerator != 1000 || r.FactorDenominator != 1001 {
+		t.Errorf("unexpected rational %+v", r)
+	}
+	if got, err := r.Rational(); err != nil || got != base.Rate29_97 {
+		t.Errorf("Rational() = %s (%v); want %s", got, err, base.Rate29_97)
+	}
+
+	r = ebucore.Rational{Value: math.MaxInt64, FactorNumerator: 2}
+	if _, err := r.Rational(); !errors.Is(err, base.ErrRationalOverflow) {
+		t.Errorf("Rational() error = %v; want %v", err, base.ErrRationalOverflow)
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	got := want.DeepCopy()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("copy differs (-want +got):\n%s", diff)
+	}
+
+	got.CoreMetadata.Format[0].ContainerFormat[0].TechnicalAttributes.TechnicalAttributeString[0].Value = "changed"
+	got.CoreMetadata.Format[0].Duration[0].NormalPlayTime.Value.Minutes = 0
+	if want.CoreMetadata.Format[0].ContainerFormat[0].TechnicalAttributes.TechnicalAttributeString[0].Value == "changed" ||
+		want.CoreMetadata.Format[0].Duration[0].NormalPlayTime.Value.Minutes == 0 {
+		t.Error("modifying the copy modified the original")
+	}
+}
+
+func TestDeepCopyArrays(t *testing.T) {
+	in := &ebucore.AudioChannelFormat{
+		Frequency: &[2]*ebucore.Frequency{{Value: 20}, nil},
+		AudioBlockFormat: []ebucore.AudioBlockFormat{{
+			Position: &[3]*ebucore.AudioBlockFormatPosition{
+				{Float: ebucore.Float{Value: 30}, Coordinate: "azimuth"},
+				{Float: ebucore.Float{Value: 0}, Coordinate: "elevation"},
+			},
+		}},
+	}
+
+	got := in.DeepCopy()
+	if diff := cmp.Diff(in, got); diff != "" {
+		t.Fatalf("copy differs (-want +got):\n%s", diff)
+	}
+
+	got.Frequency[0].Value = 40
+	got.AudioBlockFormat[0].Position[0].Value = -30
+	if in.Frequency[0].Value != 20 || in.AudioBlockFormat[0].Position[0].Value != 30 {
+		t.Error("modifying the copy modified the original")
+	}
+}
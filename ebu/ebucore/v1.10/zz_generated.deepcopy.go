@@ -0,0 +1,6282 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1_10
+
+import (
+	"github.com/nagare-media/models.go/dcmi/dc"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcquisitionData) DeepCopyInto(out *AcquisitionData) {
+	*out = *in
+	if in.ExtractionStartTime != nil {
+		in, out := &in.ExtractionStartTime, &out.ExtractionStartTime
+		*out = new(Timecode)
+		**out = **in
+	}
+	if in.ExtractionDuration != nil {
+		in, out := &in.ExtractionDuration, &out.ExtractionDuration
+		*out = new(Timecode)
+		**out = **in
+	}
+	out.AcquisitionFrameRate = in.AcquisitionFrameRate
+	if in.ParameterSegmentDataOutput != nil {
+		in, out := &in.ParameterSegmentDataOutput, &out.ParameterSegmentDataOutput
+		*out = new(ParameterSegmentDataOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SegmentParameterDataOutput != nil {
+		in, out := &in.SegmentParameterDataOutput, &out.SegmentParameterDataOutput
+		*out = new(SegmentParameterDataOutput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcquisitionData.
+func (in *AcquisitionData) DeepCopy() *AcquisitionData {
+	if in == nil {
+		return nil
+	}
+	out := new(AcquisitionData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Action) DeepCopyInto(out *Action) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.RelatedAgent.DeepCopyInto(&out.RelatedAgent)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Action.
+func (in *Action) DeepCopy() *Action {
+	if in == nil {
+		return nil
+	}
+	out := new(Action)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveArea) DeepCopyInto(out *ActiveArea) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveArea.
+func (in *ActiveArea) DeepCopy() *ActiveArea {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveArea)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalInformation) DeepCopyInto(out *AdditionalInformation) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalInformation.
+func (in *AdditionalInformation) DeepCopy() *AdditionalInformation {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalInformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Address) DeepCopyInto(out *Address) {
+	*out = *in
+	if in.AddressLine != nil {
+		in, out := &in.AddressLine, &out.AddressLine
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddressTownCity != nil {
+		in, out := &in.AddressTownCity, &out.AddressTownCity
+		*out = new(Element)
+		**out = **in
+	}
+	if in.AddressCountyState != nil {
+		in, out := &in.AddressCountyState, &out.AddressCountyState
+		*out = new(Element)
+		**out = **in
+	}
+	if in.AddressDeliveryCode != nil {
+		in, out := &in.AddressDeliveryCode, &out.AddressDeliveryCode
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.Country != nil {
+		in, out := &in.Country, &out.Country
+		*out = new(Country)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Address.
+func (in *Address) DeepCopy() *Address {
+	if in == nil {
+		return nil
+	}
+	out := new(Address)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Affiliation) DeepCopyInto(out *Affiliation) {
+	*out = *in
+	in.Organisation.DeepCopyInto(&out.Organisation)
+	in.Period.DeepCopyInto(&out.Period)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affiliation.
+func (in *Affiliation) DeepCopy() *Affiliation {
+	if in == nil {
+		return nil
+	}
+	out := new(Affiliation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentFee) DeepCopyInto(out *AgentFee) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.Value = in.Value
+	out.Currency = in.Currency
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentFee.
+func (in *AgentFee) DeepCopy() *AgentFee {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentFee)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternativeDate) DeepCopyInto(out *AlternativeDate) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternativeDate.
+func (in *AlternativeDate) DeepCopy() *AlternativeDate {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternativeDate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternativeTitle) DeepCopyInto(out *AlternativeTitle) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+	out.StatusAttributes = in.StatusAttributes
+	if in.DCTitle != nil {
+		in, out := &in.DCTitle, &out.DCTitle
+		*out = make([]dc.Title, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternativeTitle.
+func (in *AlternativeTitle) DeepCopy() *AlternativeTitle {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternativeTitle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AncillaryDataFormat) DeepCopyInto(out *AncillaryDataFormat) {
+	*out = *in
+	if in.DID != nil {
+		in, out := &in.DID, &out.DID
+		*out = new(Int)
+		**out = **in
+	}
+	if in.SDID != nil {
+		in, out := &in.SDID, &out.SDID
+		*out = new(Int)
+		**out = **in
+	}
+	if in.LineNumber != nil {
+		in, out := &in.LineNumber, &out.LineNumber
+		*out = make([]Int, len(*in))
+		copy(*out, *in)
+	}
+	if in.WrappingType != nil {
+		in, out := &in.WrappingType, &out.WrappingType
+		*out = new(Int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AncillaryDataFormat.
+func (in *AncillaryDataFormat) DeepCopy() *AncillaryDataFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AncillaryDataFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Animal) DeepCopyInto(out *Animal) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.AnimalName != nil {
+		in, out := &in.AnimalName, &out.AnimalName
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnimalDescription != nil {
+		in, out := &in.AnimalDescription, &out.AnimalDescription
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnimalCode != nil {
+		in, out := &in.AnimalCode, &out.AnimalCode
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnimalGender != nil {
+		in, out := &in.AnimalGender, &out.AnimalGender
+		*out = new(Element)
+		**out = **in
+	}
+	if in.AnimalBirthYear != nil {
+		in, out := &in.AnimalBirthYear, &out.AnimalBirthYear
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnimalPassport != nil {
+		in, out := &in.AnimalPassport, &out.AnimalPassport
+		*out = new(Element)
+		**out = **in
+	}
+	if in.AnimalColourCode != nil {
+		in, out := &in.AnimalColourCode, &out.AnimalColourCode
+		*out = make([]AnimalColourCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnimalBreedCode != nil {
+		in, out := &in.AnimalBreedCode, &out.AnimalBreedCode
+		*out = make([]AnimalBreedCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnimalOwner != nil {
+		in, out := &in.AnimalOwner, &out.AnimalOwner
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnimalGroom != nil {
+		in, out := &in.AnimalGroom, &out.AnimalGroom
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnimalRoleCode != nil {
+		in, out := &in.AnimalRoleCode, &out.AnimalRoleCode
+		*out = make([]AnimalRoleCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnimalCharacterName != nil {
+		in, out := &in.AnimalCharacterName, &out.AnimalCharacterName
+		*out = new(Element)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Animal.
+func (in *Animal) DeepCopy() *Animal {
+	if in == nil {
+		return nil
+	}
+	out := new(Animal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnimalBreedCode) DeepCopyInto(out *AnimalBreedCode) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnimalBreedCode.
+func (in *AnimalBreedCode) DeepCopy() *AnimalBreedCode {
+	if in == nil {
+		return nil
+	}
+	out := new(AnimalBreedCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnimalColourCode) DeepCopyInto(out *AnimalColourCode) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnimalColourCode.
+func (in *AnimalColourCode) DeepCopy() *AnimalColourCode {
+	if in == nil {
+		return nil
+	}
+	out := new(AnimalColourCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnimalRoleCode) DeepCopyInto(out *AnimalRoleCode) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnimalRoleCode.
+func (in *AnimalRoleCode) DeepCopy() *AnimalRoleCode {
+	if in == nil {
+		return nil
+	}
+	out := new(AnimalRoleCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Archived) DeepCopyInto(out *Archived) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Archived.
+func (in *Archived) DeepCopy() *Archived {
+	if in == nil {
+		return nil
+	}
+	out := new(Archived)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Artefact) DeepCopyInto(out *Artefact) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.ArtefactName != nil {
+		in, out := &in.ArtefactName, &out.ArtefactName
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArtefactModel != nil {
+		in, out := &in.ArtefactModel, &out.ArtefactModel
+		*out = new(String)
+		**out = **in
+	}
+	if in.ArtefactDescription != nil {
+		in, out := &in.ArtefactDescription, &out.ArtefactDescription
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArtefactBrand != nil {
+		in, out := &in.ArtefactBrand, &out.ArtefactBrand
+		*out = new(ArtefactBrand)
+		**out = **in
+	}
+	if in.ArtefactType != nil {
+		in, out := &in.ArtefactType, &out.ArtefactType
+		*out = new(ArtefactType)
+		**out = **in
+	}
+	if in.ArtefactColour != nil {
+		in, out := &in.ArtefactColour, &out.ArtefactColour
+		*out = new(String)
+		**out = **in
+	}
+	if in.ArtefactWeight != nil {
+		in, out := &in.ArtefactWeight, &out.ArtefactWeight
+		*out = new(String)
+		**out = **in
+	}
+	if in.ArtefactReference != nil {
+		in, out := &in.ArtefactReference, &out.ArtefactReference
+		*out = new(String)
+		**out = **in
+	}
+	if in.ArtefactPrice != nil {
+		in, out := &in.ArtefactPrice, &out.ArtefactPrice
+		*out = make([]ArtefactPrice, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArtefactWebsite != nil {
+		in, out := &in.ArtefactWebsite, &out.ArtefactWebsite
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.ArtefactDateOfPurchase != nil {
+		in, out := &in.ArtefactDateOfPurchase, &out.ArtefactDateOfPurchase
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtefactDateOfSell != nil {
+		in, out := &in.ArtefactDateOfSell, &out.ArtefactDateOfSell
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtefactDateOfCreation != nil {
+		in, out := &in.ArtefactDateOfCreation, &out.ArtefactDateOfCreation
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtefactDateOfAcquisition != nil {
+		in, out := &in.ArtefactDateOfAcquisition, &out.ArtefactDateOfAcquisition
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtefactDateOfDestruction != nil {
+		in, out := &in.ArtefactDateOfDestruction, &out.ArtefactDateOfDestruction
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtefactAvailability != nil {
+		in, out := &in.ArtefactAvailability, &out.ArtefactAvailability
+		*out = new(Boolean)
+		**out = **in
+	}
+	if in.ArtefactLocation != nil {
+		in, out := &in.ArtefactLocation, &out.ArtefactLocation
+		*out = new(String)
+		**out = **in
+	}
+	if in.ArtefactUsageHistory != nil {
+		in, out := &in.ArtefactUsageHistory, &out.ArtefactUsageHistory
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArtefactStyle != nil {
+		in, out := &in.ArtefactStyle, &out.ArtefactStyle
+		*out = new(Element)
+		**out = **in
+	}
+	if in.ArtefactPeriod != nil {
+		in, out := &in.ArtefactPeriod, &out.ArtefactPeriod
+		*out = new(ArtefactPeriod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtefactBoxPosition != nil {
+		in, out := &in.ArtefactBoxPosition, &out.ArtefactBoxPosition
+		*out = new(ArtefactBoxPosition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RelatedAgent != nil {
+		in, out := &in.RelatedAgent, &out.RelatedAgent
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Supplier != nil {
+		in, out := &in.Supplier, &out.Supplier
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retailer != nil {
+		in, out := &in.Retailer, &out.Retailer
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Buyer != nil {
+		in, out := &in.Buyer, &out.Buyer
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Creator != nil {
+		in, out := &in.Creator, &out.Creator
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maker != nil {
+		in, out := &in.Maker, &out.Maker
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Contact != nil {
+		in, out := &in.Contact, &out.Contact
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalInformation != nil {
+		in, out := &in.AdditionalInformation, &out.AdditionalInformation
+		*out = make([]AdditionalInformation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Artefact.
+func (in *Artefact) DeepCopy() *Artefact {
+	if in == nil {
+		return nil
+	}
+	out := new(Artefact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtefactBoxPosition) DeepCopyInto(out *ArtefactBoxPosition) {
+	*out = *in
+	if in.LeftTopCornerLineNumber != nil {
+		in, out := &in.LeftTopCornerLineNumber, &out.LeftTopCornerLineNumber
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.LeftTopCornerPixelNumber != nil {
+		in, out := &in.LeftTopCornerPixelNumber, &out.LeftTopCornerPixelNumber
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.Height != nil {
+		in, out := &in.Height, &out.Height
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.Width != nil {
+		in, out := &in.Width, &out.Width
+		*out = new(UInt)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtefactBoxPosition.
+func (in *ArtefactBoxPosition) DeepCopy() *ArtefactBoxPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtefactBoxPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtefactBrand) DeepCopyInto(out *ArtefactBrand) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtefactBrand.
+func (in *ArtefactBrand) DeepCopy() *ArtefactBrand {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtefactBrand)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtefactPeriod) DeepCopyInto(out *ArtefactPeriod) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtefactPeriod.
+func (in *ArtefactPeriod) DeepCopy() *ArtefactPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtefactPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtefactPrice) DeepCopyInto(out *ArtefactPrice) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.Value = in.Value
+	out.Currency = in.Currency
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtefactPrice.
+func (in *ArtefactPrice) DeepCopy() *ArtefactPrice {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtefactPrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtefactType) DeepCopyInto(out *ArtefactType) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtefactType.
+func (in *ArtefactType) DeepCopy() *ArtefactType {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtefactType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AspectRatio) DeepCopyInto(out *AspectRatio) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FactorNumerator = in.FactorNumerator
+	out.FactorDenominator = in.FactorDenominator
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AspectRatio.
+func (in *AspectRatio) DeepCopy() *AspectRatio {
+	if in == nil {
+		return nil
+	}
+	out := new(AspectRatio)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudienceLevel) DeepCopyInto(out *AudienceLevel) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	if in.TargetRegion != nil {
+		in, out := &in.TargetRegion, &out.TargetRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetExclusionRegion != nil {
+		in, out := &in.TargetExclusionRegion, &out.TargetExclusionRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudienceLevel.
+func (in *AudienceLevel) DeepCopy() *AudienceLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(AudienceLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioBlockFormat.
+func (in *AudioBlockFormat) DeepCopy() *AudioBlockFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioBlockFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioBlockFormatPosition) DeepCopyInto(out *AudioBlockFormatPosition) {
+	*out = *in
+	out.Float = in.Float
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioBlockFormatPosition.
+func (in *AudioBlockFormatPosition) DeepCopy() *AudioBlockFormatPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioBlockFormatPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioChannelFormat.
+func (in *AudioChannelFormat) DeepCopy() *AudioChannelFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioChannelFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioContent) DeepCopyInto(out *AudioContent) {
+	*out = *in
+	if in.AudioObjectIDRef != nil {
+		in, out := &in.AudioObjectIDRef, &out.AudioObjectIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoudnessMetadata != nil {
+		in, out := &in.LoudnessMetadata, &out.LoudnessMetadata
+		*out = new(LoudnessMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dialogue != nil {
+		in, out := &in.Dialogue, &out.Dialogue
+		*out = new(DialogueType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioContent.
+func (in *AudioContent) DeepCopy() *AudioContent {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioEncoding) DeepCopyInto(out *AudioEncoding) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioEncoding.
+func (in *AudioEncoding) DeepCopy() *AudioEncoding {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioEncoding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioFormat) DeepCopyInto(out *AudioFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.AudioEncoding != nil {
+		in, out := &in.AudioEncoding, &out.AudioEncoding
+		*out = new(AudioEncoding)
+		**out = **in
+	}
+	if in.Codec != nil {
+		in, out := &in.Codec, &out.Codec
+		*out = new(Codec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AudioTrackConfiguration != nil {
+		in, out := &in.AudioTrackConfiguration, &out.AudioTrackConfiguration
+		*out = new(AudioTrackConfiguration)
+		**out = **in
+	}
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(Int64)
+		**out = **in
+	}
+	if in.SampleSize != nil {
+		in, out := &in.SampleSize, &out.SampleSize
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.SampleType != nil {
+		in, out := &in.SampleType, &out.SampleType
+		*out = new(String)
+		**out = **in
+	}
+	if in.BitRate != nil {
+		in, out := &in.BitRate, &out.BitRate
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.BitRateMax != nil {
+		in, out := &in.BitRateMax, &out.BitRateMax
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.BitRateMode != nil {
+		in, out := &in.BitRateMode, &out.BitRateMode
+		*out = new(String)
+		**out = **in
+	}
+	if in.AudioTrack != nil {
+		in, out := &in.AudioTrack, &out.AudioTrack
+		*out = make([]AudioTrack, len(*in))
+		copy(*out, *in)
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioFormat.
+func (in *AudioFormat) DeepCopy() *AudioFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioFormatExtended) DeepCopyInto(out *AudioFormatExtended) {
+	*out = *in
+	if in.AudioProgramme != nil {
+		in, out := &in.AudioProgramme, &out.AudioProgramme
+		*out = make([]AudioProgramme, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioContent != nil {
+		in, out := &in.AudioContent, &out.AudioContent
+		*out = make([]AudioContent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioObject != nil {
+		in, out := &in.AudioObject, &out.AudioObject
+		*out = make([]AudioObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioPackFormat != nil {
+		in, out := &in.AudioPackFormat, &out.AudioPackFormat
+		*out = make([]AudioPackFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioChannelFormat != nil {
+		in, out := &in.AudioChannelFormat, &out.AudioChannelFormat
+		*out = make([]AudioChannelFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioBlockFormat != nil {
+		in, out := &in.AudioBlockFormat, &out.AudioBlockFormat
+		*out = make([]AudioBlockFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioStreamFormat != nil {
+		in, out := &in.AudioStreamFormat, &out.AudioStreamFormat
+		*out = make([]AudioStreamFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioTrackFormat != nil {
+		in, out := &in.AudioTrackFormat, &out.AudioTrackFormat
+		*out = make([]AudioTrackFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioTrackUID != nil {
+		in, out := &in.AudioTrackUID, &out.AudioTrackUID
+		*out = make([]AudioTrackUID, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioFormatExtended.
+func (in *AudioFormatExtended) DeepCopy() *AudioFormatExtended {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioFormatExtended)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioMXFLookUp) DeepCopyInto(out *AudioMXFLookUp) {
+	*out = *in
+	out.PackageUIDRef = in.PackageUIDRef
+	out.TrackIDRef = in.TrackIDRef
+	out.ChannelIDRef = in.ChannelIDRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioMXFLookUp.
+func (in *AudioMXFLookUp) DeepCopy() *AudioMXFLookUp {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioMXFLookUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioObject) DeepCopyInto(out *AudioObject) {
+	*out = *in
+	if in.AudioPackFormatIDRef != nil {
+		in, out := &in.AudioPackFormatIDRef, &out.AudioPackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioObjectIDRef != nil {
+		in, out := &in.AudioObjectIDRef, &out.AudioObjectIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioComplementaryObjectIDRef != nil {
+		in, out := &in.AudioComplementaryObjectIDRef, &out.AudioComplementaryObjectIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioTrackUIDRef != nil {
+		in, out := &in.AudioTrackUIDRef, &out.AudioTrackUIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioObjectInteraction != nil {
+		in, out := &in.AudioObjectInteraction, &out.AudioObjectInteraction
+		*out = make([]AudioObjectInteraction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioObject.
+func (in *AudioObject) DeepCopy() *AudioObject {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioObjectInteraction.
+func (in *AudioObjectInteraction) DeepCopy() *AudioObjectInteraction {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioObjectInteraction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioPackFormat) DeepCopyInto(out *AudioPackFormat) {
+	*out = *in
+	if in.AudioChannelFormatIDRef != nil {
+		in, out := &in.AudioChannelFormatIDRef, &out.AudioChannelFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioPackFormatIDRef != nil {
+		in, out := &in.AudioPackFormatIDRef, &out.AudioPackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.EncodePackFormatIDRef != nil {
+		in, out := &in.EncodePackFormatIDRef, &out.EncodePackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.DecodePackFormatIDRef != nil {
+		in, out := &in.DecodePackFormatIDRef, &out.DecodePackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.InputPackFormatIDRef != nil {
+		in, out := &in.InputPackFormatIDRef, &out.InputPackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputPackFormatIDRef != nil {
+		in, out := &in.OutputPackFormatIDRef, &out.OutputPackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.Normalization != nil {
+		in, out := &in.Normalization, &out.Normalization
+		*out = new(String)
+		**out = **in
+	}
+	if in.NfcRefDist != nil {
+		in, out := &in.NfcRefDist, &out.NfcRefDist
+		*out = new(Int)
+		**out = **in
+	}
+	if in.ScreenRef != nil {
+		in, out := &in.ScreenRef, &out.ScreenRef
+		*out = new(Boolean)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioPackFormat.
+func (in *AudioPackFormat) DeepCopy() *AudioPackFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioPackFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioProgramme) DeepCopyInto(out *AudioProgramme) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	if in.AudioContentIDRef != nil {
+		in, out := &in.AudioContentIDRef, &out.AudioContentIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoudnessMetadata != nil {
+		in, out := &in.LoudnessMetadata, &out.LoudnessMetadata
+		*out = new(LoudnessMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AudioProgrammeReferenceScreen != nil {
+		in, out := &in.AudioProgrammeReferenceScreen, &out.AudioProgrammeReferenceScreen
+		*out = new(AudioProgrammeReferenceScreen)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioProgramme.
+func (in *AudioProgramme) DeepCopy() *AudioProgramme {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioProgramme)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioProgrammeReferenceScreen) DeepCopyInto(out *AudioProgrammeReferenceScreen) {
+	*out = *in
+	if in.AspectRatio != nil {
+		in, out := &in.AspectRatio, &out.AspectRatio
+		*out = new(Float)
+		**out = **in
+	}
+	if in.ScreenCentrePosition != nil {
+		in, out := &in.ScreenCentrePosition, &out.ScreenCentrePosition
+		*out = new(ScreenCentrePosition)
+		**out = **in
+	}
+	if in.ScreenWidth != nil {
+		in, out := &in.ScreenWidth, &out.ScreenWidth
+		*out = new(ScreenWidth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioProgrammeReferenceScreen.
+func (in *AudioProgrammeReferenceScreen) DeepCopy() *AudioProgrammeReferenceScreen {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioProgrammeReferenceScreen)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioStreamFormat) DeepCopyInto(out *AudioStreamFormat) {
+	*out = *in
+	out.FormatAttributes = in.FormatAttributes
+	if in.AudioChannelFormatIDRef != nil {
+		in, out := &in.AudioChannelFormatIDRef, &out.AudioChannelFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioPackFormatIDRef != nil {
+		in, out := &in.AudioPackFormatIDRef, &out.AudioPackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.AudioTrackFormatIDRef != nil {
+		in, out := &in.AudioTrackFormatIDRef, &out.AudioTrackFormatIDRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioStreamFormat.
+func (in *AudioStreamFormat) DeepCopy() *AudioStreamFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioStreamFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioTrack) DeepCopyInto(out *AudioTrack) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioTrack.
+func (in *AudioTrack) DeepCopy() *AudioTrack {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioTrack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioTrackConfiguration) DeepCopyInto(out *AudioTrackConfiguration) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioTrackConfiguration.
+func (in *AudioTrackConfiguration) DeepCopy() *AudioTrackConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioTrackConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioTrackFormat) DeepCopyInto(out *AudioTrackFormat) {
+	*out = *in
+	out.FormatAttributes = in.FormatAttributes
+	if in.AudioStreamFormatIDRef != nil {
+		in, out := &in.AudioStreamFormatIDRef, &out.AudioStreamFormatIDRef
+		*out = new(String)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioTrackFormat.
+func (in *AudioTrackFormat) DeepCopy() *AudioTrackFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioTrackFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioTrackUID) DeepCopyInto(out *AudioTrackUID) {
+	*out = *in
+	if in.AudioMXFLookUp != nil {
+		in, out := &in.AudioMXFLookUp, &out.AudioMXFLookUp
+		*out = new(AudioMXFLookUp)
+		**out = **in
+	}
+	if in.AudioTrackFormatIDRef != nil {
+		in, out := &in.AudioTrackFormatIDRef, &out.AudioTrackFormatIDRef
+		*out = new(String)
+		**out = **in
+	}
+	if in.AudioPackFormatIDRef != nil {
+		in, out := &in.AudioPackFormatIDRef, &out.AudioPackFormatIDRef
+		*out = new(String)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioTrackUID.
+func (in *AudioTrackUID) DeepCopy() *AudioTrackUID {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioTrackUID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Award) DeepCopyInto(out *Award) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = make([]Category, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ceremony != nil {
+		in, out := &in.Ceremony, &out.Ceremony
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Official != nil {
+		in, out := &in.Official, &out.Official
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Date != nil {
+		in, out := &in.Date, &out.Date
+		*out = make([]Date, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Award.
+func (in *Award) DeepCopy() *Award {
+	if in == nil {
+		return nil
+	}
+	out := new(Award)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Boolean) DeepCopyInto(out *Boolean) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Boolean.
+func (in *Boolean) DeepCopy() *Boolean {
+	if in == nil {
+		return nil
+	}
+	out := new(Boolean)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptioningFormat) DeepCopyInto(out *CaptioningFormat) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptioningFormat.
+func (in *CaptioningFormat) DeepCopy() *CaptioningFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptioningFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Category) DeepCopyInto(out *Category) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Category.
+func (in *Category) DeepCopy() *Category {
+	if in == nil {
+		return nil
+	}
+	out := new(Category)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelLock) DeepCopyInto(out *ChannelLock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelLock.
+func (in *ChannelLock) DeepCopy() *ChannelLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CharacterName) DeepCopyInto(out *CharacterName) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CharacterName.
+func (in *CharacterName) DeepCopy() *CharacterName {
+	if in == nil {
+		return nil
+	}
+	out := new(CharacterName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Code) DeepCopyInto(out *Code) {
+	*out = *in
+	out.URIValue = in.URIValue
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Code.
+func (in *Code) DeepCopy() *Code {
+	if in == nil {
+		return nil
+	}
+	out := new(Code)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Codec) DeepCopyInto(out *Codec) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.CodecIdentifier != nil {
+		in, out := &in.CodecIdentifier, &out.CodecIdentifier
+		*out = new(Identifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(String)
+		**out = **in
+	}
+	if in.Vendor != nil {
+		in, out := &in.Vendor, &out.Vendor
+		*out = new(String)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(String)
+		**out = **in
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(String)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URIValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Codec.
+func (in *Codec) DeepCopy() *Codec {
+	if in == nil {
+		return nil
+	}
+	out := new(Codec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Coefficient) DeepCopyInto(out *Coefficient) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Coefficient.
+func (in *Coefficient) DeepCopy() *Coefficient {
+	if in == nil {
+		return nil
+	}
+	out := new(Coefficient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Comment) DeepCopyInto(out *Comment) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Comment.
+func (in *Comment) DeepCopy() *Comment {
+	if in == nil {
+		return nil
+	}
+	out := new(Comment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompoundName) DeepCopyInto(out *CompoundName) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompoundName.
+func (in *CompoundName) DeepCopy() *CompoundName {
+	if in == nil {
+		return nil
+	}
+	out := new(CompoundName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactDetails) DeepCopyInto(out *ContactDetails) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = make([]CompoundName, len(*in))
+		copy(*out, *in)
+	}
+	if in.GivenName != nil {
+		in, out := &in.GivenName, &out.GivenName
+		*out = new(Element)
+		**out = **in
+	}
+	if in.FamilyName != nil {
+		in, out := &in.FamilyName, &out.FamilyName
+		*out = new(Element)
+		**out = **in
+	}
+	if in.OtherGivenName != nil {
+		in, out := &in.OtherGivenName, &out.OtherGivenName
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Suffix != nil {
+		in, out := &in.Suffix, &out.Suffix
+		*out = new(Element)
+		**out = **in
+	}
+	if in.Salutation != nil {
+		in, out := &in.Salutation, &out.Salutation
+		*out = new(Element)
+		**out = **in
+	}
+	if in.BirthDate != nil {
+		in, out := &in.BirthDate, &out.BirthDate
+		*out = make([]Date, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeathDate != nil {
+		in, out := &in.DeathDate, &out.DeathDate
+		*out = make([]Date, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BirthPlace != nil {
+		in, out := &in.BirthPlace, &out.BirthPlace
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeathPlace != nil {
+		in, out := &in.DeathPlace, &out.DeathPlace
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nationality != nil {
+		in, out := &in.Nationality, &out.Nationality
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nickname != nil {
+		in, out := &in.Nickname, &out.Nickname
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Occupation != nil {
+		in, out := &in.Occupation, &out.Occupation
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make([]Details, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StageName != nil {
+		in, out := &in.StageName, &out.StageName
+		*out = make([]StageName, len(*in))
+		copy(*out, *in)
+	}
+	if in.CharacterName != nil {
+		in, out := &in.CharacterName, &out.CharacterName
+		*out = make([]CharacterName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Guest != nil {
+		in, out := &in.Guest, &out.Guest
+		*out = make([]Boolean, len(*in))
+		copy(*out, *in)
+	}
+	if in.Gender != nil {
+		in, out := &in.Gender, &out.Gender
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.RelatedInformationLink != nil {
+		in, out := &in.RelatedInformationLink, &out.RelatedInformationLink
+		*out = make([]RelatedInformationLink, len(*in))
+		copy(*out, *in)
+	}
+	if in.RelatedContacts != nil {
+		in, out := &in.RelatedContacts, &out.RelatedContacts
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Skill != nil {
+		in, out := &in.Skill, &out.Skill
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.Affiliation != nil {
+		in, out := &in.Affiliation, &out.Affiliation
+		*out = make([]Affiliation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalInformation != nil {
+		in, out := &in.AdditionalInformation, &out.AdditionalInformation
+		*out = make([]AdditionalInformation, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactDetails.
+func (in *ContactDetails) DeepCopy() *ContactDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerEncoding) DeepCopyInto(out *ContainerEncoding) {
+	*out = *in
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerEncoding.
+func (in *ContainerEncoding) DeepCopy() *ContainerEncoding {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerEncoding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerFormat) DeepCopyInto(out *ContainerFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.ContainerEncoding != nil {
+		in, out := &in.ContainerEncoding, &out.ContainerEncoding
+		*out = new(ContainerEncoding)
+		**out = **in
+	}
+	if in.Codec != nil {
+		in, out := &in.Codec, &out.Codec
+		*out = new(Codec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerFormat.
+func (in *ContainerFormat) DeepCopy() *ContainerFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentFormat) DeepCopyInto(out *ContentFormat) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentFormat.
+func (in *ContentFormat) DeepCopy() *ContentFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentMax) DeepCopyInto(out *ContentMax) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentMax.
+func (in *ContentMax) DeepCopy() *ContentMax {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentMax)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Coordinates) DeepCopyInto(out *Coordinates) {
+	*out = *in
+	out.FormatAttributes = in.FormatAttributes
+	out.PosY = in.PosY
+	out.PosX = in.PosX
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Coordinates.
+func (in *Coordinates) DeepCopy() *Coordinates {
+	if in == nil {
+		return nil
+	}
+	out := new(Coordinates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Copyrighted) DeepCopyInto(out *Copyrighted) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Copyrighted.
+func (in *Copyrighted) DeepCopy() *Copyrighted {
+	if in == nil {
+		return nil
+	}
+	out := new(Copyrighted)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreMetadata) DeepCopyInto(out *CoreMetadata) {
+	*out = *in
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = make([]Title, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlternativeTitle != nil {
+		in, out := &in.AlternativeTitle, &out.AlternativeTitle
+		*out = make([]AlternativeTitle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Creator != nil {
+		in, out := &in.Creator, &out.Creator
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = make([]Subject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = make([]Topic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Theme != nil {
+		in, out := &in.Theme, &out.Theme
+		*out = make([]Theme, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = make([]Description, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Publisher != nil {
+		in, out := &in.Publisher, &out.Publisher
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Contributor != nil {
+		in, out := &in.Contributor, &out.Contributor
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Date != nil {
+		in, out := &in.Date, &out.Date
+		*out = make([]ResourceDates, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = make([]Type, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = make([]Format, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Identifier != nil {
+		in, out := &in.Identifier, &out.Identifier
+		*out = make([]Identifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DCSource != nil {
+		in, out := &in.DCSource, &out.DCSource
+		*out = make([]dc.Source, len(*in))
+		copy(*out, *in)
+	}
+	if in.Language != nil {
+		in, out := &in.Language, &out.Language
+		*out = make([]Language, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Relation != nil {
+		in, out := &in.Relation, &out.Relation
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsRelatedTo != nil {
+		in, out := &in.IsRelatedTo, &out.IsRelatedTo
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsNextInSequence != nil {
+		in, out := &in.IsNextInSequence, &out.IsNextInSequence
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FollowsInSequence != nil {
+		in, out := &in.FollowsInSequence, &out.FollowsInSequence
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsVersionOf != nil {
+		in, out := &in.IsVersionOf, &out.IsVersionOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasVersion != nil {
+		in, out := &in.HasVersion, &out.HasVersion
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsReplacedBy != nil {
+		in, out := &in.IsReplacedBy, &out.IsReplacedBy
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replaces != nil {
+		in, out := &in.Replaces, &out.Replaces
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsRequiredBy != nil {
+		in, out := &in.IsRequiredBy, &out.IsRequiredBy
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsPartOf != nil {
+		in, out := &in.IsPartOf, &out.IsPartOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasPart != nil {
+		in, out := &in.HasPart, &out.HasPart
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasTrackPart != nil {
+		in, out := &in.HasTrackPart, &out.HasTrackPart
+		*out = make([]HasTrackPart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsTrackPartOf != nil {
+		in, out := &in.IsTrackPartOf, &out.IsTrackPartOf
+		*out = make([]IsTrackPartOf, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsFormatOf != nil {
+		in, out := &in.IsFormatOf, &out.IsFormatOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasFormat != nil {
+		in, out := &in.HasFormat, &out.HasFormat
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsEpisodeOf != nil {
+		in, out := &in.IsEpisodeOf, &out.IsEpisodeOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsSeasonOf != nil {
+		in, out := &in.IsSeasonOf, &out.IsSeasonOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasEpisode != nil {
+		in, out := &in.HasEpisode, &out.HasEpisode
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasSeason != nil {
+		in, out := &in.HasSeason, &out.HasSeason
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasSeries != nil {
+		in, out := &in.HasSeries, &out.HasSeries
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsSeriesOf != nil {
+		in, out := &in.IsSeriesOf, &out.IsSeriesOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsMemberOf != nil {
+		in, out := &in.IsMemberOf, &out.IsMemberOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasMember != nil {
+		in, out := &in.HasMember, &out.HasMember
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SameAs != nil {
+		in, out := &in.SameAs, &out.SameAs
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasParent != nil {
+		in, out := &in.HasParent, &out.HasParent
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsParentOf != nil {
+		in, out := &in.IsParentOf, &out.IsParentOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasChild != nil {
+		in, out := &in.HasChild, &out.HasChild
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsChildOf != nil {
+		in, out := &in.IsChildOf, &out.IsChildOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasMaster != nil {
+		in, out := &in.HasMaster, &out.HasMaster
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsMasterOf != nil {
+		in, out := &in.IsMasterOf, &out.IsMasterOf
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsDerivedFrom != nil {
+		in, out := &in.IsDerivedFrom, &out.IsDerivedFrom
+		*out = make([]Relation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HasManifestation != nil {
+		in, out := &in.HasManifestation, &out.HasManifestation
+		*out = make([]Manifestation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Coverage != nil {
+		in, out := &in.Coverage, &out.Coverage
+		*out = make([]Coverage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rights != nil {
+		in, out := &in.Rights, &out.Rights
+		*out = make([]Rights, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = make([]Version, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicationHistory != nil {
+		in, out := &in.PublicationHistory, &out.PublicationHistory
+		*out = make([]PublicationHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Planning != nil {
+		in, out := &in.Planning, &out.Planning
+		*out = make([]Planning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rating != nil {
+		in, out := &in.Rating, &out.Rating
+		*out = make([]Rating, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudienceRating != nil {
+		in, out := &in.AudienceRating, &out.AudienceRating
+		*out = make([]Rating, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Event != nil {
+		in, out := &in.Event, &out.Event
+		*out = make([]Event, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Artefact != nil {
+		in, out := &in.Artefact, &out.Artefact
+		*out = make([]Artefact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Animal != nil {
+		in, out := &in.Animal, &out.Animal
+		*out = make([]Animal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Props != nil {
+		in, out := &in.Props, &out.Props
+		*out = make([]Props, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Costume != nil {
+		in, out := &in.Costume, &out.Costume
+		*out = make([]Costume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Food != nil {
+		in, out := &in.Food, &out.Food
+		*out = make([]Food, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TextLine != nil {
+		in, out := &in.TextLine, &out.TextLine
+		*out = make([]TextLine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Emotion != nil {
+		in, out := &in.Emotion, &out.Emotion
+		*out = make([]Emotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = make([]Action, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Part != nil {
+		in, out := &in.Part, &out.Part
+		*out = make([]Part, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreMetadata.
+func (in *CoreMetadata) DeepCopy() *CoreMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Costume) DeepCopyInto(out *Costume) {
+	*out = *in
+	in.Artefact.DeepCopyInto(&out.Artefact)
+	if in.CostumeSizeInformation != nil {
+		in, out := &in.CostumeSizeInformation, &out.CostumeSizeInformation
+		*out = new(CostumeSizeInformation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CostumeGender != nil {
+		in, out := &in.CostumeGender, &out.CostumeGender
+		*out = new(CostumeGender)
+		**out = **in
+	}
+	if in.CostumeTexture != nil {
+		in, out := &in.CostumeTexture, &out.CostumeTexture
+		*out = make([]CostumeTexture, len(*in))
+		copy(*out, *in)
+	}
+	if in.LinkToLogo != nil {
+		in, out := &in.LinkToLogo, &out.LinkToLogo
+		*out = make([]URIValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.LinkToSticker != nil {
+		in, out := &in.LinkToSticker, &out.LinkToSticker
+		*out = make([]URIValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Costume.
+func (in *Costume) DeepCopy() *Costume {
+	if in == nil {
+		return nil
+	}
+	out := new(Costume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostumeGender) DeepCopyInto(out *CostumeGender) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostumeGender.
+func (in *CostumeGender) DeepCopy() *CostumeGender {
+	if in == nil {
+		return nil
+	}
+	out := new(CostumeGender)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostumeSizeCategory) DeepCopyInto(out *CostumeSizeCategory) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostumeSizeCategory.
+func (in *CostumeSizeCategory) DeepCopy() *CostumeSizeCategory {
+	if in == nil {
+		return nil
+	}
+	out := new(CostumeSizeCategory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostumeSizeInformation) DeepCopyInto(out *CostumeSizeInformation) {
+	*out = *in
+	if in.CostumeSizeCategory != nil {
+		in, out := &in.CostumeSizeCategory, &out.CostumeSizeCategory
+		*out = new(CostumeSizeCategory)
+		**out = **in
+	}
+	out.CostumeSize = in.CostumeSize
+	if in.GeographicalArea != nil {
+		in, out := &in.GeographicalArea, &out.GeographicalArea
+		*out = new(GeographicalArea)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostumeSizeInformation.
+func (in *CostumeSizeInformation) DeepCopy() *CostumeSizeInformation {
+	if in == nil {
+		return nil
+	}
+	out := new(CostumeSizeInformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostumeTexture) DeepCopyInto(out *CostumeTexture) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostumeTexture.
+func (in *CostumeTexture) DeepCopy() *CostumeTexture {
+	if in == nil {
+		return nil
+	}
+	out := new(CostumeTexture)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Country) DeepCopyInto(out *Country) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Country.
+func (in *Country) DeepCopy() *Country {
+	if in == nil {
+		return nil
+	}
+	out := new(Country)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CountryRegion) DeepCopyInto(out *CountryRegion) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CountryRegion.
+func (in *CountryRegion) DeepCopy() *CountryRegion {
+	if in == nil {
+		return nil
+	}
+	out := new(CountryRegion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Coverage) DeepCopyInto(out *Coverage) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.DCCoverage != nil {
+		in, out := &in.DCCoverage, &out.DCCoverage
+		*out = new(dc.Coverage)
+		**out = **in
+	}
+	if in.Temporal != nil {
+		in, out := &in.Temporal, &out.Temporal
+		*out = new(Temporal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spatial != nil {
+		in, out := &in.Spatial, &out.Spatial
+		*out = new(Spatial)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Coverage.
+func (in *Coverage) DeepCopy() *Coverage {
+	if in == nil {
+		return nil
+	}
+	out := new(Coverage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Created) DeepCopyInto(out *Created) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Created.
+func (in *Created) DeepCopy() *Created {
+	if in == nil {
+		return nil
+	}
+	out := new(Created)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CuisineOrigin) DeepCopyInto(out *CuisineOrigin) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CuisineOrigin.
+func (in *CuisineOrigin) DeepCopy() *CuisineOrigin {
+	if in == nil {
+		return nil
+	}
+	out := new(CuisineOrigin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CuisineStyle) DeepCopyInto(out *CuisineStyle) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CuisineStyle.
+func (in *CuisineStyle) DeepCopy() *CuisineStyle {
+	if in == nil {
+		return nil
+	}
+	out := new(CuisineStyle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Currency) DeepCopyInto(out *Currency) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Currency.
+func (in *Currency) DeepCopy() *Currency {
+	if in == nil {
+		return nil
+	}
+	out := new(Currency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDurationValue) DeepCopyInto(out *CustomDurationValue) {
+	*out = *in
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDurationValue.
+func (in *CustomDurationValue) DeepCopy() *CustomDurationValue {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDurationValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTimeValue) DeepCopyInto(out *CustomTimeValue) {
+	*out = *in
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTimeValue.
+func (in *CustomTimeValue) DeepCopy() *CustomTimeValue {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTimeValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFormat) DeepCopyInto(out *DataFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.CaptioningFormat != nil {
+		in, out := &in.CaptioningFormat, &out.CaptioningFormat
+		*out = make([]CaptioningFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubtitlingFormat != nil {
+		in, out := &in.SubtitlingFormat, &out.SubtitlingFormat
+		*out = make([]SubtitlingFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.AncillaryDataFormat != nil {
+		in, out := &in.AncillaryDataFormat, &out.AncillaryDataFormat
+		*out = make([]AncillaryDataFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Codec != nil {
+		in, out := &in.Codec, &out.Codec
+		*out = new(Codec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFormat.
+func (in *DataFormat) DeepCopy() *DataFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Date) DeepCopyInto(out *Date) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Date.
+func (in *Date) DeepCopy() *Date {
+	if in == nil {
+		return nil
+	}
+	out := new(Date)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateAttributes) DeepCopyInto(out *DateAttributes) {
+	*out = *in
+	in.Date.DeepCopyInto(&out.Date)
+	in.Time.DeepCopyInto(&out.Time)
+	in.StartDate.DeepCopyInto(&out.StartDate)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndDate.DeepCopyInto(&out.EndDate)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateAttributes.
+func (in *DateAttributes) DeepCopy() *DateAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(DateAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateCreated) DeepCopyInto(out *DateCreated) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateCreated.
+func (in *DateCreated) DeepCopy() *DateCreated {
+	if in == nil {
+		return nil
+	}
+	out := new(DateCreated)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateModified) DeepCopyInto(out *DateModified) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateModified.
+func (in *DateModified) DeepCopy() *DateModified {
+	if in == nil {
+		return nil
+	}
+	out := new(DateModified)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deleted) DeepCopyInto(out *Deleted) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deleted.
+func (in *Deleted) DeepCopy() *Deleted {
+	if in == nil {
+		return nil
+	}
+	out := new(Deleted)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Description) DeepCopyInto(out *Description) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+	if in.DCDescription != nil {
+		in, out := &in.DCDescription, &out.DCDescription
+		*out = make([]dc.Description, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attributor != nil {
+		in, out := &in.Attributor, &out.Attributor
+		*out = new(Entity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Description.
+func (in *Description) DeepCopy() *Description {
+	if in == nil {
+		return nil
+	}
+	out := new(Description)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Details) DeepCopyInto(out *Details) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.EmailAddress != nil {
+		in, out := &in.EmailAddress, &out.EmailAddress
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.WebAddress != nil {
+		in, out := &in.WebAddress, &out.WebAddress
+		*out = new(String)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(Address)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TelephoneNumber != nil {
+		in, out := &in.TelephoneNumber, &out.TelephoneNumber
+		*out = new(String)
+		**out = **in
+	}
+	if in.MobileTelephoneNumber != nil {
+		in, out := &in.MobileTelephoneNumber, &out.MobileTelephoneNumber
+		*out = new(String)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Details.
+func (in *Details) DeepCopy() *Details {
+	if in == nil {
+		return nil
+	}
+	out := new(Details)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DialogueType) DeepCopyInto(out *DialogueType) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DialogueType.
+func (in *DialogueType) DeepCopy() *DialogueType {
+	if in == nil {
+		return nil
+	}
+	out := new(DialogueType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DigitalAssetChromaticity) DeepCopyInto(out *DigitalAssetChromaticity) {
+	*out = *in
+	out.ChromaticityCIEx = in.ChromaticityCIEx
+	out.ChromaticityCIEy = in.ChromaticityCIEy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DigitalAssetChromaticity.
+func (in *DigitalAssetChromaticity) DeepCopy() *DigitalAssetChromaticity {
+	if in == nil {
+		return nil
+	}
+	out := new(DigitalAssetChromaticity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DigitalAssetColorVolume) DeepCopyInto(out *DigitalAssetColorVolume) {
+	*out = *in
+	out.PrimaryRChromaticity = in.PrimaryRChromaticity
+	out.PrimaryGChromaticity = in.PrimaryGChromaticity
+	out.PrimaryBChromaticity = in.PrimaryBChromaticity
+	out.WhitePointChromaticity = in.WhitePointChromaticity
+	out.LuminanceMin = in.LuminanceMin
+	out.LuminanceMax = in.LuminanceMax
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DigitalAssetColorVolume.
+func (in *DigitalAssetColorVolume) DeepCopy() *DigitalAssetColorVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DigitalAssetColorVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DigitalAssetVideoPictureLightLevel) DeepCopyInto(out *DigitalAssetVideoPictureLightLevel) {
+	*out = *in
+	if in.ContentMax != nil {
+		in, out := &in.ContentMax, &out.ContentMax
+		*out = make([]ContentMax, len(*in))
+		copy(*out, *in)
+	}
+	if in.FrameAverageMax != nil {
+		in, out := &in.FrameAverageMax, &out.FrameAverageMax
+		*out = make([]FrameAverageMax, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DigitalAssetVideoPictureLightLevel.
+func (in *DigitalAssetVideoPictureLightLevel) DeepCopy() *DigitalAssetVideoPictureLightLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(DigitalAssetVideoPictureLightLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Digitised) DeepCopyInto(out *Digitised) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Digitised.
+func (in *Digitised) DeepCopy() *Digitised {
+	if in == nil {
+		return nil
+	}
+	out := new(Digitised)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dimension) DeepCopyInto(out *Dimension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dimension.
+func (in *Dimension) DeepCopy() *Dimension {
+	if in == nil {
+		return nil
+	}
+	out := new(Dimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentFormat) DeepCopyInto(out *DocumentFormat) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.WordCount != nil {
+		in, out := &in.WordCount, &out.WordCount
+		*out = new(Int)
+		**out = **in
+	}
+	if in.RegionDelimX != nil {
+		in, out := &in.RegionDelimX, &out.RegionDelimX
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.RegionDelimY != nil {
+		in, out := &in.RegionDelimY, &out.RegionDelimY
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Width != nil {
+		in, out := &in.Width, &out.Width
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Height != nil {
+		in, out := &in.Height, &out.Height
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentFormat.
+func (in *DocumentFormat) DeepCopy() *DocumentFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Duration) DeepCopyInto(out *Duration) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Timecode != nil {
+		in, out := &in.Timecode, &out.Timecode
+		*out = new(Timecode)
+		**out = **in
+	}
+	if in.NormalPlayTime != nil {
+		in, out := &in.NormalPlayTime, &out.NormalPlayTime
+		*out = new(DurationValue)
+		**out = **in
+	}
+	if in.EditUnitNumber != nil {
+		in, out := &in.EditUnitNumber, &out.EditUnitNumber
+		*out = new(EditUnitNumber)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(CustomDurationValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Duration.
+func (in *Duration) DeepCopy() *Duration {
+	if in == nil {
+		return nil
+	}
+	out := new(Duration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationValue) DeepCopyInto(out *DurationValue) {
+	*out = *in
+	out.Value = in.Value
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationValue.
+func (in *DurationValue) DeepCopy() *DurationValue {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EditUnitNumber) DeepCopyInto(out *EditUnitNumber) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EditUnitNumber.
+func (in *EditUnitNumber) DeepCopy() *EditUnitNumber {
+	if in == nil {
+		return nil
+	}
+	out := new(EditUnitNumber)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Element) DeepCopyInto(out *Element) {
+	*out = *in
+	out.SimpleLiteral = in.SimpleLiteral
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Element.
+func (in *Element) DeepCopy() *Element {
+	if in == nil {
+		return nil
+	}
+	out := new(Element)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Emotion) DeepCopyInto(out *Emotion) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.RelatedAgent.DeepCopyInto(&out.RelatedAgent)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Emotion.
+func (in *Emotion) DeepCopy() *Emotion {
+	if in == nil {
+		return nil
+	}
+	out := new(Emotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Encoded) DeepCopyInto(out *Encoded) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Encoded.
+func (in *Encoded) DeepCopy() *Encoded {
+	if in == nil {
+		return nil
+	}
+	out := new(Encoded)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Entity) DeepCopyInto(out *Entity) {
+	*out = *in
+	if in.ContactDetails != nil {
+		in, out := &in.ContactDetails, &out.ContactDetails
+		*out = make([]ContactDetails, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrganisationDetails != nil {
+		in, out := &in.OrganisationDetails, &out.OrganisationDetails
+		*out = make([]OrganisationDetails, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = make([]Role, len(*in))
+		copy(*out, *in)
+	}
+	if in.Award != nil {
+		in, out := &in.Award, &out.Award
+		*out = make([]Award, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Event != nil {
+		in, out := &in.Event, &out.Event
+		*out = make([]Event, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AgentFee != nil {
+		in, out := &in.AgentFee, &out.AgentFee
+		*out = make([]AgentFee, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Entity.
+func (in *Entity) DeepCopy() *Entity {
+	if in == nil {
+		return nil
+	}
+	out := new(Entity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Event) DeepCopyInto(out *Event) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]Location, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Event.
+func (in *Event) DeepCopy() *Event {
+	if in == nil {
+		return nil
+	}
+	out := new(Event)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileInfo) DeepCopyInto(out *FileInfo) {
+	*out = *in
+	if in.FileSize != nil {
+		in, out := &in.FileSize, &out.FileSize
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.FileName != nil {
+		in, out := &in.FileName, &out.FileName
+		*out = new(String)
+		**out = **in
+	}
+	if in.MimeType != nil {
+		in, out := &in.MimeType, &out.MimeType
+		*out = make([]MimeType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Locator != nil {
+		in, out := &in.Locator, &out.Locator
+		*out = make([]Locator, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hash != nil {
+		in, out := &in.Hash, &out.Hash
+		*out = new(Hash)
+		**out = **in
+	}
+	if in.OverallBitRate != nil {
+		in, out := &in.OverallBitRate, &out.OverallBitRate
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.EditRate != nil {
+		in, out := &in.EditRate, &out.EditRate
+		*out = new(Rational)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileInfo.
+func (in *FileInfo) DeepCopy() *FileInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FileInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.TrackIdRef != nil {
+		in, out := &in.TrackIdRef, &out.TrackIdRef
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	out.FilterProfile = in.FilterProfile
+	if in.FilterSetting != nil {
+		in, out := &in.FilterSetting, &out.FilterSetting
+		*out = make([]FilterSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterProfile) DeepCopyInto(out *FilterProfile) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterProfile.
+func (in *FilterProfile) DeepCopy() *FilterProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterSetting) DeepCopyInto(out *FilterSetting) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSetting.
+func (in *FilterSetting) DeepCopy() *FilterSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Float) DeepCopyInto(out *Float) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Float.
+func (in *Float) DeepCopy() *Float {
+	if in == nil {
+		return nil
+	}
+	out := new(Float)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Food) DeepCopyInto(out *Food) {
+	*out = *in
+	in.Artefact.DeepCopyInto(&out.Artefact)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Food.
+func (in *Food) DeepCopy() *Food {
+	if in == nil {
+		return nil
+	}
+	out := new(Food)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoodCategory) DeepCopyInto(out *FoodCategory) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoodCategory.
+func (in *FoodCategory) DeepCopy() *FoodCategory {
+	if in == nil {
+		return nil
+	}
+	out := new(FoodCategory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoodStyle) DeepCopyInto(out *FoodStyle) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoodStyle.
+func (in *FoodStyle) DeepCopy() *FoodStyle {
+	if in == nil {
+		return nil
+	}
+	out := new(FoodStyle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoodType) DeepCopyInto(out *FoodType) {
+	*out = *in
+	in.Artefact.DeepCopyInto(&out.Artefact)
+	if in.FoodStyle != nil {
+		in, out := &in.FoodStyle, &out.FoodStyle
+		*out = new(FoodStyle)
+		**out = **in
+	}
+	if in.FoodCategory != nil {
+		in, out := &in.FoodCategory, &out.FoodCategory
+		*out = new(FoodCategory)
+		**out = **in
+	}
+	if in.CuisineOrigin != nil {
+		in, out := &in.CuisineOrigin, &out.CuisineOrigin
+		*out = make([]CuisineOrigin, len(*in))
+		copy(*out, *in)
+	}
+	if in.CuisineStyle != nil {
+		in, out := &in.CuisineStyle, &out.CuisineStyle
+		*out = new(CuisineStyle)
+		**out = **in
+	}
+	if in.DishName != nil {
+		in, out := &in.DishName, &out.DishName
+		*out = new(Element)
+		**out = **in
+	}
+	if in.DishDescription != nil {
+		in, out := &in.DishDescription, &out.DishDescription
+		*out = new(Element)
+		**out = **in
+	}
+	if in.FoodIngredient != nil {
+		in, out := &in.FoodIngredient, &out.FoodIngredient
+		*out = new(Element)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoodType.
+func (in *FoodType) DeepCopy() *FoodType {
+	if in == nil {
+		return nil
+	}
+	out := new(FoodType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Format.
+func (in *Format) DeepCopy() *Format {
+	if in == nil {
+		return nil
+	}
+	out := new(Format)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FormatAttributes) DeepCopyInto(out *FormatAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FormatAttributes.
+func (in *FormatAttributes) DeepCopy() *FormatAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(FormatAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrameAverageMax) DeepCopyInto(out *FrameAverageMax) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrameAverageMax.
+func (in *FrameAverageMax) DeepCopy() *FrameAverageMax {
+	if in == nil {
+		return nil
+	}
+	out := new(FrameAverageMax)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Frequency) DeepCopyInto(out *Frequency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Frequency.
+func (in *Frequency) DeepCopy() *Frequency {
+	if in == nil {
+		return nil
+	}
+	out := new(Frequency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GainInteractionRange) DeepCopyInto(out *GainInteractionRange) {
+	*out = *in
+	out.Float = in.Float
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GainInteractionRange.
+func (in *GainInteractionRange) DeepCopy() *GainInteractionRange {
+	if in == nil {
+		return nil
+	}
+	out := new(GainInteractionRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Genre) DeepCopyInto(out *Genre) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Genre.
+func (in *Genre) DeepCopy() *Genre {
+	if in == nil {
+		return nil
+	}
+	out := new(Genre)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeographicalArea) DeepCopyInto(out *GeographicalArea) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeographicalArea.
+func (in *GeographicalArea) DeepCopy() *GeographicalArea {
+	if in == nil {
+		return nil
+	}
+	out := new(GeographicalArea)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HDRMetadata) DeepCopyInto(out *HDRMetadata) {
+	*out = *in
+	out.Width = in.Width
+	out.Height = in.Height
+	if in.ActiveArea != nil {
+		in, out := &in.ActiveArea, &out.ActiveArea
+		*out = new(ActiveArea)
+		**out = **in
+	}
+	if in.MasteredColorVolume != nil {
+		in, out := &in.MasteredColorVolume, &out.MasteredColorVolume
+		*out = new(MasteredColorVolume)
+		**out = **in
+	}
+	out.LightLevel = in.LightLevel
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HDRMetadata.
+func (in *HDRMetadata) DeepCopy() *HDRMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(HDRMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HasTrackPart) DeepCopyInto(out *HasTrackPart) {
+	*out = *in
+	in.Relation.DeepCopyInto(&out.Relation)
+	in.TrackPartTitle.DeepCopyInto(&out.TrackPartTitle)
+	if in.DestinationID != nil {
+		in, out := &in.DestinationID, &out.DestinationID
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.DestinationStart != nil {
+		in, out := &in.DestinationStart, &out.DestinationStart
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationEnd != nil {
+		in, out := &in.DestinationEnd, &out.DestinationEnd
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceID != nil {
+		in, out := &in.SourceID, &out.SourceID
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.SourceStart != nil {
+		in, out := &in.SourceStart, &out.SourceStart
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceEnd != nil {
+		in, out := &in.SourceEnd, &out.SourceEnd
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HasTrackPart.
+func (in *HasTrackPart) DeepCopy() *HasTrackPart {
+	if in == nil {
+		return nil
+	}
+	out := new(HasTrackPart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hash) DeepCopyInto(out *Hash) {
+	*out = *in
+	out.Function = in.Function
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hash.
+func (in *Hash) DeepCopy() *Hash {
+	if in == nil {
+		return nil
+	}
+	out := new(Hash)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashFunction) DeepCopyInto(out *HashFunction) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashFunction.
+func (in *HashFunction) DeepCopy() *HashFunction {
+	if in == nil {
+		return nil
+	}
+	out := new(HashFunction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Height) DeepCopyInto(out *Height) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.Dimension = in.Dimension
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Height.
+func (in *Height) DeepCopy() *Height {
+	if in == nil {
+		return nil
+	}
+	out := new(Height)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Identifier) DeepCopyInto(out *Identifier) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	out.DCIdentifier = in.DCIdentifier
+	if in.Attributor != nil {
+		in, out := &in.Attributor, &out.Attributor
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Identifier.
+func (in *Identifier) DeepCopy() *Identifier {
+	if in == nil {
+		return nil
+	}
+	out := new(Identifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageEncoding) DeepCopyInto(out *ImageEncoding) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageEncoding.
+func (in *ImageEncoding) DeepCopy() *ImageEncoding {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageEncoding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFormat) DeepCopyInto(out *ImageFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.RegionDelimX != nil {
+		in, out := &in.RegionDelimX, &out.RegionDelimX
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.RegionDelimY != nil {
+		in, out := &in.RegionDelimY, &out.RegionDelimY
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Width != nil {
+		in, out := &in.Width, &out.Width
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Height != nil {
+		in, out := &in.Height, &out.Height
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Orientation != nil {
+		in, out := &in.Orientation, &out.Orientation
+		*out = new(String)
+		**out = **in
+	}
+	if in.AspectRatio != nil {
+		in, out := &in.AspectRatio, &out.AspectRatio
+		*out = new(AspectRatio)
+		**out = **in
+	}
+	if in.ImageEncoding != nil {
+		in, out := &in.ImageEncoding, &out.ImageEncoding
+		*out = new(ImageEncoding)
+		**out = **in
+	}
+	if in.ImageCodec != nil {
+		in, out := &in.ImageCodec, &out.ImageCodec
+		*out = new(Codec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFormat.
+func (in *ImageFormat) DeepCopy() *ImageFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ingested) DeepCopyInto(out *Ingested) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingested.
+func (in *Ingested) DeepCopy() *Ingested {
+	if in == nil {
+		return nil
+	}
+	out := new(Ingested)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int) DeepCopyInto(out *Int) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int.
+func (in *Int) DeepCopy() *Int {
+	if in == nil {
+		return nil
+	}
+	out := new(Int)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int16) DeepCopyInto(out *Int16) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int16.
+func (in *Int16) DeepCopy() *Int16 {
+	if in == nil {
+		return nil
+	}
+	out := new(Int16)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int32) DeepCopyInto(out *Int32) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int32.
+func (in *Int32) DeepCopy() *Int32 {
+	if in == nil {
+		return nil
+	}
+	out := new(Int32)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int64) DeepCopyInto(out *Int64) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int64.
+func (in *Int64) DeepCopy() *Int64 {
+	if in == nil {
+		return nil
+	}
+	out := new(Int64)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int8) DeepCopyInto(out *Int8) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int8.
+func (in *Int8) DeepCopy() *Int8 {
+	if in == nil {
+		return nil
+	}
+	out := new(Int8)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsTrackPartOf) DeepCopyInto(out *IsTrackPartOf) {
+	*out = *in
+	in.Relation.DeepCopyInto(&out.Relation)
+	in.TrackPartTitle.DeepCopyInto(&out.TrackPartTitle)
+	if in.DestinationID != nil {
+		in, out := &in.DestinationID, &out.DestinationID
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.DestinationStart != nil {
+		in, out := &in.DestinationStart, &out.DestinationStart
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationEnd != nil {
+		in, out := &in.DestinationEnd, &out.DestinationEnd
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceID != nil {
+		in, out := &in.SourceID, &out.SourceID
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.SourceStart != nil {
+		in, out := &in.SourceStart, &out.SourceStart
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceEnd != nil {
+		in, out := &in.SourceEnd, &out.SourceEnd
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsTrackPartOf.
+func (in *IsTrackPartOf) DeepCopy() *IsTrackPartOf {
+	if in == nil {
+		return nil
+	}
+	out := new(IsTrackPartOf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Issued) DeepCopyInto(out *Issued) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Issued.
+func (in *Issued) DeepCopy() *Issued {
+	if in == nil {
+		return nil
+	}
+	out := new(Issued)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JumpPosition) DeepCopyInto(out *JumpPosition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JumpPosition.
+func (in *JumpPosition) DeepCopy() *JumpPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(JumpPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Language) DeepCopyInto(out *Language) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.DCLanguage != nil {
+		in, out := &in.DCLanguage, &out.DCLanguage
+		*out = make([]dc.Language, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Language.
+func (in *Language) DeepCopy() *Language {
+	if in == nil {
+		return nil
+	}
+	out := new(Language)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Length) DeepCopyInto(out *Length) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Length.
+func (in *Length) DeepCopy() *Length {
+	if in == nil {
+		return nil
+	}
+	out := new(Length)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightLevel) DeepCopyInto(out *LightLevel) {
+	*out = *in
+	out.MaxCLL = in.MaxCLL
+	out.MaxFall = in.MaxFall
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightLevel.
+func (in *LightLevel) DeepCopy() *LightLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(LightLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Location) DeepCopyInto(out *Location) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = make([]Name, len(*in))
+		copy(*out, *in)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Coordinates != nil {
+		in, out := &in.Coordinates, &out.Coordinates
+		*out = new(Coordinates)
+		**out = **in
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = make([]Code, len(*in))
+		copy(*out, *in)
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Altitude != nil {
+		in, out := &in.Altitude, &out.Altitude
+		*out = new(Float)
+		**out = **in
+	}
+	if in.AdditionalInformation != nil {
+		in, out := &in.AdditionalInformation, &out.AdditionalInformation
+		*out = make([]AdditionalInformation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Location.
+func (in *Location) DeepCopy() *Location {
+	if in == nil {
+		return nil
+	}
+	out := new(Location)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Locator) DeepCopyInto(out *Locator) {
+	*out = *in
+	out.URIValue = in.URIValue
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Locator.
+func (in *Locator) DeepCopy() *Locator {
+	if in == nil {
+		return nil
+	}
+	out := new(Locator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoudnessMetadata) DeepCopyInto(out *LoudnessMetadata) {
+	*out = *in
+	if in.IntegratedLoudness != nil {
+		in, out := &in.IntegratedLoudness, &out.IntegratedLoudness
+		*out = new(Float)
+		**out = **in
+	}
+	if in.LoudnessRange != nil {
+		in, out := &in.LoudnessRange, &out.LoudnessRange
+		*out = new(Float)
+		**out = **in
+	}
+	if in.MaxTruePeak != nil {
+		in, out := &in.MaxTruePeak, &out.MaxTruePeak
+		*out = new(Float)
+		**out = **in
+	}
+	if in.MaxMomentary != nil {
+		in, out := &in.MaxMomentary, &out.MaxMomentary
+		*out = new(Float)
+		**out = **in
+	}
+	if in.MaxShortTerm != nil {
+		in, out := &in.MaxShortTerm, &out.MaxShortTerm
+		*out = new(Float)
+		**out = **in
+	}
+	if in.DialogLoudness != nil {
+		in, out := &in.DialogLoudness, &out.DialogLoudness
+		*out = new(Float)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoudnessMetadata.
+func (in *LoudnessMetadata) DeepCopy() *LoudnessMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(LoudnessMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Main) DeepCopyInto(out *Main) {
+	*out = *in
+	out.XMLName = in.XMLName
+	out.TypeAttributes = in.TypeAttributes
+	in.CoreMetadata.DeepCopyInto(&out.CoreMetadata)
+	if in.MetadataProvider != nil {
+		in, out := &in.MetadataProvider, &out.MetadataProvider
+		*out = new(Entity)
+		(*in).DeepCopyInto(*out)
+	}
+	in.DateLastModified.DeepCopyInto(&out.DateLastModified)
+	in.TimeLastModified.DeepCopyInto(&out.TimeLastModified)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Main.
+func (in *Main) DeepCopy() *Main {
+	if in == nil {
+		return nil
+	}
+	out := new(Main)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Manifestation) DeepCopyInto(out *Manifestation) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	if in.ManifestationTitle != nil {
+		in, out := &in.ManifestationTitle, &out.ManifestationTitle
+		*out = make([]Title, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestationIdentifier != nil {
+		in, out := &in.ManifestationIdentifier, &out.ManifestationIdentifier
+		*out = make([]Identifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestationDate != nil {
+		in, out := &in.ManifestationDate, &out.ManifestationDate
+		*out = make([]ResourceDates, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestationPublicationHistory != nil {
+		in, out := &in.ManifestationPublicationHistory, &out.ManifestationPublicationHistory
+		*out = make([]PublicationHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manifestation.
+func (in *Manifestation) DeepCopy() *Manifestation {
+	if in == nil {
+		return nil
+	}
+	out := new(Manifestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasteredColorVolume) DeepCopyInto(out *MasteredColorVolume) {
+	*out = *in
+	out.PrimaryRChromaticity = in.PrimaryRChromaticity
+	out.PrimaryGChromaticity = in.PrimaryGChromaticity
+	out.PrimaryBChromaticity = in.PrimaryBChromaticity
+	out.WhitePointChromaticity = in.WhitePointChromaticity
+	out.LuminanceMin = in.LuminanceMin
+	out.LuminanceMax = in.LuminanceMax
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasteredColorVolume.
+func (in *MasteredColorVolume) DeepCopy() *MasteredColorVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(MasteredColorVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Matrix) DeepCopyInto(out *Matrix) {
+	*out = *in
+	if in.Coefficient != nil {
+		in, out := &in.Coefficient, &out.Coefficient
+		*out = make([]Coefficient, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Matrix.
+func (in *Matrix) DeepCopy() *Matrix {
+	if in == nil {
+		return nil
+	}
+	out := new(Matrix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Medium) DeepCopyInto(out *Medium) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Medium.
+func (in *Medium) DeepCopy() *Medium {
+	if in == nil {
+		return nil
+	}
+	out := new(Medium)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataFormat) DeepCopyInto(out *MetadataFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.MetadataTrack != nil {
+		in, out := &in.MetadataTrack, &out.MetadataTrack
+		*out = make([]MetadataTrack, len(*in))
+		copy(*out, *in)
+	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(Duration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataFormat.
+func (in *MetadataFormat) DeepCopy() *MetadataFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataTrack) DeepCopyInto(out *MetadataTrack) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataTrack.
+func (in *MetadataTrack) DeepCopy() *MetadataTrack {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataTrack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MimeType) DeepCopyInto(out *MimeType) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MimeType.
+func (in *MimeType) DeepCopy() *MimeType {
+	if in == nil {
+		return nil
+	}
+	out := new(MimeType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Modified) DeepCopyInto(out *Modified) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Modified.
+func (in *Modified) DeepCopy() *Modified {
+	if in == nil {
+		return nil
+	}
+	out := new(Modified)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Name) DeepCopyInto(out *Name) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Name.
+func (in *Name) DeepCopy() *Name {
+	if in == nil {
+		return nil
+	}
+	out := new(Name)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoiseFilter) DeepCopyInto(out *NoiseFilter) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoiseFilter.
+func (in *NoiseFilter) DeepCopy() *NoiseFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NoiseFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDivergence) DeepCopyInto(out *ObjectDivergence) {
+	*out = *in
+	out.Float = in.Float
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDivergence.
+func (in *ObjectDivergence) DeepCopy() *ObjectDivergence {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDivergence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectType) DeepCopyInto(out *ObjectType) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectType.
+func (in *ObjectType) DeepCopy() *ObjectType {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganisationDepartment) DeepCopyInto(out *OrganisationDepartment) {
+	*out = *in
+	out.Element = in.Element
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganisationDepartment.
+func (in *OrganisationDepartment) DeepCopy() *OrganisationDepartment {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganisationDepartment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganisationDetails) DeepCopyInto(out *OrganisationDetails) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.OrganisationName != nil {
+		in, out := &in.OrganisationName, &out.OrganisationName
+		*out = make([]CompoundName, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganisationCode != nil {
+		in, out := &in.OrganisationCode, &out.OrganisationCode
+		*out = make([]Identifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrganisationDescription != nil {
+		in, out := &in.OrganisationDescription, &out.OrganisationDescription
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganisationNationality != nil {
+		in, out := &in.OrganisationNationality, &out.OrganisationNationality
+		*out = new(String)
+		**out = **in
+	}
+	if in.OrganisationDepartment != nil {
+		in, out := &in.OrganisationDepartment, &out.OrganisationDepartment
+		*out = new(OrganisationDepartment)
+		**out = **in
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make([]Details, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RelatedInformationLink != nil {
+		in, out := &in.RelatedInformationLink, &out.RelatedInformationLink
+		*out = make([]RelatedInformationLink, len(*in))
+		copy(*out, *in)
+	}
+	if in.Contacts != nil {
+		in, out := &in.Contacts, &out.Contacts
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganisationDetails.
+func (in *OrganisationDetails) DeepCopy() *OrganisationDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganisationDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageID) DeepCopyInto(out *PackageID) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageID.
+func (in *PackageID) DeepCopy() *PackageID {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Parameter) DeepCopyInto(out *Parameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
+	if in == nil {
+		return nil
+	}
+	out := new(Parameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSegment) DeepCopyInto(out *ParameterSegment) {
+	*out = *in
+	if in.Segment != nil {
+		in, out := &in.Segment, &out.Segment
+		*out = make([]Segment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSegment.
+func (in *ParameterSegment) DeepCopy() *ParameterSegment {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSegment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSegmentDataOutput) DeepCopyInto(out *ParameterSegmentDataOutput) {
+	*out = *in
+	if in.Parameter != nil {
+		in, out := &in.Parameter, &out.Parameter
+		*out = make([]ParameterSegment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSegmentDataOutput.
+func (in *ParameterSegmentDataOutput) DeepCopy() *ParameterSegmentDataOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSegmentDataOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Part) DeepCopyInto(out *Part) {
+	*out = *in
+	in.CoreMetadata.DeepCopyInto(&out.CoreMetadata)
+	out.TypeAttributes = in.TypeAttributes
+	if in.PartStartTime != nil {
+		in, out := &in.PartStartTime, &out.PartStartTime
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PartDuration != nil {
+		in, out := &in.PartDuration, &out.PartDuration
+		*out = new(Duration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PartEndTime != nil {
+		in, out := &in.PartEndTime, &out.PartEndTime
+		*out = new(Time)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Part.
+func (in *Part) DeepCopy() *Part {
+	if in == nil {
+		return nil
+	}
+	out := new(Part)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Period) DeepCopyInto(out *Period) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Period.
+func (in *Period) DeepCopy() *Period {
+	if in == nil {
+		return nil
+	}
+	out := new(Period)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeriodOfTime) DeepCopyInto(out *PeriodOfTime) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+	if in.PeriodName != nil {
+		in, out := &in.PeriodName, &out.PeriodName
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeriodOfTime.
+func (in *PeriodOfTime) DeepCopy() *PeriodOfTime {
+	if in == nil {
+		return nil
+	}
+	out := new(PeriodOfTime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Planned) DeepCopyInto(out *Planned) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Planned.
+func (in *Planned) DeepCopy() *Planned {
+	if in == nil {
+		return nil
+	}
+	out := new(Planned)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Planning) DeepCopyInto(out *Planning) {
+	*out = *in
+	if in.PublicationEvent != nil {
+		in, out := &in.PublicationEvent, &out.PublicationEvent
+		*out = make([]PublicationEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Planning.
+func (in *Planning) DeepCopy() *Planning {
+	if in == nil {
+		return nil
+	}
+	out := new(Planning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PositionInteractionRange) DeepCopyInto(out *PositionInteractionRange) {
+	*out = *in
+	out.Float = in.Float
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PositionInteractionRange.
+func (in *PositionInteractionRange) DeepCopy() *PositionInteractionRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PositionInteractionRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrimaryBChromaticity) DeepCopyInto(out *PrimaryBChromaticity) {
+	*out = *in
+	out.ChromaticityCIEx = in.ChromaticityCIEx
+	out.ChromaticityCIEy = in.ChromaticityCIEy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrimaryBChromaticity.
+func (in *PrimaryBChromaticity) DeepCopy() *PrimaryBChromaticity {
+	if in == nil {
+		return nil
+	}
+	out := new(PrimaryBChromaticity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrimaryGChromaticity) DeepCopyInto(out *PrimaryGChromaticity) {
+	*out = *in
+	out.ChromaticityCIEx = in.ChromaticityCIEx
+	out.ChromaticityCIEy = in.ChromaticityCIEy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrimaryGChromaticity.
+func (in *PrimaryGChromaticity) DeepCopy() *PrimaryGChromaticity {
+	if in == nil {
+		return nil
+	}
+	out := new(PrimaryGChromaticity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrimaryRChromaticity) DeepCopyInto(out *PrimaryRChromaticity) {
+	*out = *in
+	out.ChromaticityCIEx = in.ChromaticityCIEx
+	out.ChromaticityCIEy = in.ChromaticityCIEy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrimaryRChromaticity.
+func (in *PrimaryRChromaticity) DeepCopy() *PrimaryRChromaticity {
+	if in == nil {
+		return nil
+	}
+	out := new(PrimaryRChromaticity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessingRestrictionFlag) DeepCopyInto(out *ProcessingRestrictionFlag) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessingRestrictionFlag.
+func (in *ProcessingRestrictionFlag) DeepCopy() *ProcessingRestrictionFlag {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessingRestrictionFlag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Produced) DeepCopyInto(out *Produced) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Produced.
+func (in *Produced) DeepCopy() *Produced {
+	if in == nil {
+		return nil
+	}
+	out := new(Produced)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Props) DeepCopyInto(out *Props) {
+	*out = *in
+	in.Artefact.DeepCopyInto(&out.Artefact)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Props.
+func (in *Props) DeepCopy() *Props {
+	if in == nil {
+		return nil
+	}
+	out := new(Props)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationChannel) DeepCopyInto(out *PublicationChannel) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationChannel.
+func (in *PublicationChannel) DeepCopy() *PublicationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationEvent) DeepCopyInto(out *PublicationEvent) {
+	*out = *in
+	if in.PublicationDate != nil {
+		in, out := &in.PublicationDate, &out.PublicationDate
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicationTime != nil {
+		in, out := &in.PublicationTime, &out.PublicationTime
+		*out = new(TimeValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicationDuration != nil {
+		in, out := &in.PublicationDuration, &out.PublicationDuration
+		*out = new(DurationValue)
+		**out = **in
+	}
+	if in.ScheduleDate != nil {
+		in, out := &in.ScheduleDate, &out.ScheduleDate
+		*out = new(Date)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicationService != nil {
+		in, out := &in.PublicationService, &out.PublicationService
+		*out = new(PublicationService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicationMedium != nil {
+		in, out := &in.PublicationMedium, &out.PublicationMedium
+		*out = new(PublicationMedium)
+		**out = **in
+	}
+	if in.PublicationChannel != nil {
+		in, out := &in.PublicationChannel, &out.PublicationChannel
+		*out = new(PublicationChannel)
+		**out = **in
+	}
+	if in.PublicationRegion != nil {
+		in, out := &in.PublicationRegion, &out.PublicationRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RelatedPublicationEvent != nil {
+		in, out := &in.RelatedPublicationEvent, &out.RelatedPublicationEvent
+		*out = make([]PublicationEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationEvent.
+func (in *PublicationEvent) DeepCopy() *PublicationEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationHistory) DeepCopyInto(out *PublicationHistory) {
+	*out = *in
+	if in.PublicationEvent != nil {
+		in, out := &in.PublicationEvent, &out.PublicationEvent
+		*out = make([]PublicationEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationHistory.
+func (in *PublicationHistory) DeepCopy() *PublicationHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationMedium) DeepCopyInto(out *PublicationMedium) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationMedium.
+func (in *PublicationMedium) DeepCopy() *PublicationMedium {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationMedium)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationService) DeepCopyInto(out *PublicationService) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.PublicationServiceName != nil {
+		in, out := &in.PublicationServiceName, &out.PublicationServiceName
+		*out = new(String)
+		**out = **in
+	}
+	if in.PublicationSource != nil {
+		in, out := &in.PublicationSource, &out.PublicationSource
+		*out = new(OrganisationDetails)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationService.
+func (in *PublicationService) DeepCopy() *PublicationService {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rating) DeepCopyInto(out *Rating) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	if in.RatingValue != nil {
+		in, out := &in.RatingValue, &out.RatingValue
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.RatingLink != nil {
+		in, out := &in.RatingLink, &out.RatingLink
+		*out = make([]URIValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.RatingScaleMaxValue != nil {
+		in, out := &in.RatingScaleMaxValue, &out.RatingScaleMaxValue
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.RatingScaleMinValue != nil {
+		in, out := &in.RatingScaleMinValue, &out.RatingScaleMinValue
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.RatingProvider != nil {
+		in, out := &in.RatingProvider, &out.RatingProvider
+		*out = new(Entity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RatingRegion != nil {
+		in, out := &in.RatingRegion, &out.RatingRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RatingExclusionRegion != nil {
+		in, out := &in.RatingExclusionRegion, &out.RatingExclusionRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rating.
+func (in *Rating) DeepCopy() *Rating {
+	if in == nil {
+		return nil
+	}
+	out := new(Rating)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rational) DeepCopyInto(out *Rational) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rational.
+func (in *Rational) DeepCopy() *Rational {
+	if in == nil {
+		return nil
+	}
+	out := new(Rational)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Region) DeepCopyInto(out *Region) {
+	*out = *in
+	if in.Country != nil {
+		in, out := &in.Country, &out.Country
+		*out = new(Country)
+		**out = **in
+	}
+	if in.CountryRegion != nil {
+		in, out := &in.CountryRegion, &out.CountryRegion
+		*out = make([]CountryRegion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
+func (in *Region) DeepCopy() *Region {
+	if in == nil {
+		return nil
+	}
+	out := new(Region)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelatedInformationLink) DeepCopyInto(out *RelatedInformationLink) {
+	*out = *in
+	out.URIValue = in.URIValue
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelatedInformationLink.
+func (in *RelatedInformationLink) DeepCopy() *RelatedInformationLink {
+	if in == nil {
+		return nil
+	}
+	out := new(RelatedInformationLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Relation) DeepCopyInto(out *Relation) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.DCRelation != nil {
+		in, out := &in.DCRelation, &out.DCRelation
+		*out = new(dc.Relation)
+		**out = **in
+	}
+	if in.RelationIdentifier != nil {
+		in, out := &in.RelationIdentifier, &out.RelationIdentifier
+		*out = new(Identifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RelationLink != nil {
+		in, out := &in.RelationLink, &out.RelationLink
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.RelationSource != nil {
+		in, out := &in.RelationSource, &out.RelationSource
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Relation.
+func (in *Relation) DeepCopy() *Relation {
+	if in == nil {
+		return nil
+	}
+	out := new(Relation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Released) DeepCopyInto(out *Released) {
+	*out = *in
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Released.
+func (in *Released) DeepCopy() *Released {
+	if in == nil {
+		return nil
+	}
+	out := new(Released)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDates) DeepCopyInto(out *ResourceDates) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	if in.DCDate != nil {
+		in, out := &in.DCDate, &out.DCDate
+		*out = make([]dc.Date, len(*in))
+		copy(*out, *in)
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = new(Created)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Issued != nil {
+		in, out := &in.Issued, &out.Issued
+		*out = new(Issued)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Modified != nil {
+		in, out := &in.Modified, &out.Modified
+		*out = new(Modified)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Digitised != nil {
+		in, out := &in.Digitised, &out.Digitised
+		*out = new(Digitised)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Released != nil {
+		in, out := &in.Released, &out.Released
+		*out = make([]Released, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Copyrighted != nil {
+		in, out := &in.Copyrighted, &out.Copyrighted
+		*out = new(Copyrighted)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encoded != nil {
+		in, out := &in.Encoded, &out.Encoded
+		*out = new(Encoded)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alternative != nil {
+		in, out := &in.Alternative, &out.Alternative
+		*out = make([]AlternativeDate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ingested != nil {
+		in, out := &in.Ingested, &out.Ingested
+		*out = new(Ingested)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Archived != nil {
+		in, out := &in.Archived, &out.Archived
+		*out = new(Archived)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deleted != nil {
+		in, out := &in.Deleted, &out.Deleted
+		*out = new(Deleted)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Produced != nil {
+		in, out := &in.Produced, &out.Produced
+		*out = new(Produced)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Planned != nil {
+		in, out := &in.Planned, &out.Planned
+		*out = new(Planned)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Note != nil {
+		in, out := &in.Note, &out.Note
+		*out = new(String)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDates.
+func (in *ResourceDates) DeepCopy() *ResourceDates {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rights) DeepCopyInto(out *Rights) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.DCRights != nil {
+		in, out := &in.DCRights, &out.DCRights
+		*out = make([]dc.Rights, len(*in))
+		copy(*out, *in)
+	}
+	if in.RightsLink != nil {
+		in, out := &in.RightsLink, &out.RightsLink
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.RightsHolder != nil {
+		in, out := &in.RightsHolder, &out.RightsHolder
+		*out = make([]Entity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExploitationIssues != nil {
+		in, out := &in.ExploitationIssues, &out.ExploitationIssues
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.CopyrightStatement != nil {
+		in, out := &in.CopyrightStatement, &out.CopyrightStatement
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Coverage != nil {
+		in, out := &in.Coverage, &out.Coverage
+		*out = make([]Coverage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RightsClearanceFlag != nil {
+		in, out := &in.RightsClearanceFlag, &out.RightsClearanceFlag
+		*out = new(Boolean)
+		**out = **in
+	}
+	if in.ProcessingRestrictionFlag != nil {
+		in, out := &in.ProcessingRestrictionFlag, &out.ProcessingRestrictionFlag
+		*out = new(ProcessingRestrictionFlag)
+		**out = **in
+	}
+	if in.Disclaimer != nil {
+		in, out := &in.Disclaimer, &out.Disclaimer
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.RightsAttributedID != nil {
+		in, out := &in.RightsAttributedID, &out.RightsAttributedID
+		*out = make([]Identifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContactDetails != nil {
+		in, out := &in.ContactDetails, &out.ContactDetails
+		*out = make([]ContactDetails, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RightsEncoding != nil {
+		in, out := &in.RightsEncoding, &out.RightsEncoding
+		*out = new(RightsEncoding)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rights.
+func (in *Rights) DeepCopy() *Rights {
+	if in == nil {
+		return nil
+	}
+	out := new(Rights)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RightsEncoding) DeepCopyInto(out *RightsEncoding) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RightsEncoding.
+func (in *RightsEncoding) DeepCopy() *RightsEncoding {
+	if in == nil {
+		return nil
+	}
+	out := new(RightsEncoding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScreenCentrePosition) DeepCopyInto(out *ScreenCentrePosition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScreenCentrePosition.
+func (in *ScreenCentrePosition) DeepCopy() *ScreenCentrePosition {
+	if in == nil {
+		return nil
+	}
+	out := new(ScreenCentrePosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScreenWidth) DeepCopyInto(out *ScreenWidth) {
+	*out = *in
+	out.Float = in.Float
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScreenWidth.
+func (in *ScreenWidth) DeepCopy() *ScreenWidth {
+	if in == nil {
+		return nil
+	}
+	out := new(ScreenWidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Segment) DeepCopyInto(out *Segment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Segment.
+func (in *Segment) DeepCopy() *Segment {
+	if in == nil {
+		return nil
+	}
+	out := new(Segment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SegmentParameter) DeepCopyInto(out *SegmentParameter) {
+	*out = *in
+	if in.Parameter != nil {
+		in, out := &in.Parameter, &out.Parameter
+		*out = make([]Parameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SegmentParameter.
+func (in *SegmentParameter) DeepCopy() *SegmentParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(SegmentParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SegmentParameterDataOutput) DeepCopyInto(out *SegmentParameterDataOutput) {
+	*out = *in
+	if in.Segment != nil {
+		in, out := &in.Segment, &out.Segment
+		*out = make([]SegmentParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SegmentParameterDataOutput.
+func (in *SegmentParameterDataOutput) DeepCopy() *SegmentParameterDataOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(SegmentParameterDataOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SigningFormat) DeepCopyInto(out *SigningFormat) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SigningFormat.
+func (in *SigningFormat) DeepCopy() *SigningFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(SigningFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Spatial) DeepCopyInto(out *Spatial) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]Location, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Spatial.
+func (in *Spatial) DeepCopy() *Spatial {
+	if in == nil {
+		return nil
+	}
+	out := new(Spatial)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeakerLabel) DeepCopyInto(out *SpeakerLabel) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeakerLabel.
+func (in *SpeakerLabel) DeepCopy() *SpeakerLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(SpeakerLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageName) DeepCopyInto(out *StageName) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageName.
+func (in *StageName) DeepCopy() *StageName {
+	if in == nil {
+		return nil
+	}
+	out := new(StageName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusAttributes) DeepCopyInto(out *StatusAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusAttributes.
+func (in *StatusAttributes) DeepCopy() *StatusAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *String) DeepCopyInto(out *String) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new String.
+func (in *String) DeepCopy() *String {
+	if in == nil {
+		return nil
+	}
+	out := new(String)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subject) DeepCopyInto(out *Subject) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.DCSubject != nil {
+		in, out := &in.DCSubject, &out.DCSubject
+		*out = make([]dc.Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubjectCode != nil {
+		in, out := &in.SubjectCode, &out.SubjectCode
+		*out = new(URIValue)
+		**out = **in
+	}
+	if in.SubjectDefinition != nil {
+		in, out := &in.SubjectDefinition, &out.SubjectDefinition
+		*out = make([]Element, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attributor != nil {
+		in, out := &in.Attributor, &out.Attributor
+		*out = new(Entity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subject.
+func (in *Subject) DeepCopy() *Subject {
+	if in == nil {
+		return nil
+	}
+	out := new(Subject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubtitlingFormat) DeepCopyInto(out *SubtitlingFormat) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubtitlingFormat.
+func (in *SubtitlingFormat) DeepCopy() *SubtitlingFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(SubtitlingFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetAudience) DeepCopyInto(out *TargetAudience) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.FormatAttributes = in.FormatAttributes
+	if in.TargetRegion != nil {
+		in, out := &in.TargetRegion, &out.TargetRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetExclusionRegion != nil {
+		in, out := &in.TargetExclusionRegion, &out.TargetExclusionRegion
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetAudience.
+func (in *TargetAudience) DeepCopy() *TargetAudience {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetAudience)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TechnicalAttributeRational) DeepCopyInto(out *TechnicalAttributeRational) {
+	*out = *in
+	out.Rational = in.Rational
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TechnicalAttributeRational.
+func (in *TechnicalAttributeRational) DeepCopy() *TechnicalAttributeRational {
+	if in == nil {
+		return nil
+	}
+	out := new(TechnicalAttributeRational)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TechnicalAttributeTimecode) DeepCopyInto(out *TechnicalAttributeTimecode) {
+	*out = *in
+	out.Timecode = in.Timecode
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TechnicalAttributeTimecode.
+func (in *TechnicalAttributeTimecode) DeepCopy() *TechnicalAttributeTimecode {
+	if in == nil {
+		return nil
+	}
+	out := new(TechnicalAttributeTimecode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TechnicalAttributeURI) DeepCopyInto(out *TechnicalAttributeURI) {
+	*out = *in
+	out.URIValue = in.URIValue
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TechnicalAttributeURI.
+func (in *TechnicalAttributeURI) DeepCopy() *TechnicalAttributeURI {
+	if in == nil {
+		return nil
+	}
+	out := new(TechnicalAttributeURI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TechnicalAttributes) DeepCopyInto(out *TechnicalAttributes) {
+	*out = *in
+	if in.TechnicalAttributeString != nil {
+		in, out := &in.TechnicalAttributeString, &out.TechnicalAttributeString
+		*out = make([]String, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeByte != nil {
+		in, out := &in.TechnicalAttributeByte, &out.TechnicalAttributeByte
+		*out = make([]Int8, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeShort != nil {
+		in, out := &in.TechnicalAttributeShort, &out.TechnicalAttributeShort
+		*out = make([]Int16, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeInteger != nil {
+		in, out := &in.TechnicalAttributeInteger, &out.TechnicalAttributeInteger
+		*out = make([]Int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeLong != nil {
+		in, out := &in.TechnicalAttributeLong, &out.TechnicalAttributeLong
+		*out = make([]Int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeUnsignedByte != nil {
+		in, out := &in.TechnicalAttributeUnsignedByte, &out.TechnicalAttributeUnsignedByte
+		*out = make([]UInt8, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeUnsignedShort != nil {
+		in, out := &in.TechnicalAttributeUnsignedShort, &out.TechnicalAttributeUnsignedShort
+		*out = make([]UInt16, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeUnsignedInteger != nil {
+		in, out := &in.TechnicalAttributeUnsignedInteger, &out.TechnicalAttributeUnsignedInteger
+		*out = make([]UInt32, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeUnsignedLong != nil {
+		in, out := &in.TechnicalAttributeUnsignedLong, &out.TechnicalAttributeUnsignedLong
+		*out = make([]UInt64, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeBoolean != nil {
+		in, out := &in.TechnicalAttributeBoolean, &out.TechnicalAttributeBoolean
+		*out = make([]Boolean, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeFloat != nil {
+		in, out := &in.TechnicalAttributeFloat, &out.TechnicalAttributeFloat
+		*out = make([]Float, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeRational != nil {
+		in, out := &in.TechnicalAttributeRational, &out.TechnicalAttributeRational
+		*out = make([]TechnicalAttributeRational, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeURI != nil {
+		in, out := &in.TechnicalAttributeURI, &out.TechnicalAttributeURI
+		*out = make([]TechnicalAttributeURI, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalAttributeTimecode != nil {
+		in, out := &in.TechnicalAttributeTimecode, &out.TechnicalAttributeTimecode
+		*out = make([]TechnicalAttributeTimecode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TechnicalAttributes.
+func (in *TechnicalAttributes) DeepCopy() *TechnicalAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(TechnicalAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Temporal) DeepCopyInto(out *Temporal) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.PeriodOfTime != nil {
+		in, out := &in.PeriodOfTime, &out.PeriodOfTime
+		*out = make([]PeriodOfTime, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Temporal.
+func (in *Temporal) DeepCopy() *Temporal {
+	if in == nil {
+		return nil
+	}
+	out := new(Temporal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TextLine) DeepCopyInto(out *TextLine) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.TextLink = in.TextLink
+	out.Text = in.Text
+	in.RelatedAgent.DeepCopyInto(&out.RelatedAgent)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.TextLineBoxartefacttyPosition != nil {
+		in, out := &in.TextLineBoxartefacttyPosition, &out.TextLineBoxartefacttyPosition
+		*out = new(TextLineBoxartefacttyPosition)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TextLine.
+func (in *TextLine) DeepCopy() *TextLine {
+	if in == nil {
+		return nil
+	}
+	out := new(TextLine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TextLineBoxartefacttyPosition) DeepCopyInto(out *TextLineBoxartefacttyPosition) {
+	*out = *in
+	if in.LeftTopCornerLineNumber != nil {
+		in, out := &in.LeftTopCornerLineNumber, &out.LeftTopCornerLineNumber
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.LeftTopCornerPixelNumber != nil {
+		in, out := &in.LeftTopCornerPixelNumber, &out.LeftTopCornerPixelNumber
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.Height != nil {
+		in, out := &in.Height, &out.Height
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.Width != nil {
+		in, out := &in.Width, &out.Width
+		*out = new(UInt)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TextLineBoxartefacttyPosition.
+func (in *TextLineBoxartefacttyPosition) DeepCopy() *TextLineBoxartefacttyPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(TextLineBoxartefacttyPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Theme) DeepCopyInto(out *Theme) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Attributor != nil {
+		in, out := &in.Attributor, &out.Attributor
+		*out = new(Entity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Theme.
+func (in *Theme) DeepCopy() *Theme {
+	if in == nil {
+		return nil
+	}
+	out := new(Theme)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Time) DeepCopyInto(out *Time) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Timecode != nil {
+		in, out := &in.Timecode, &out.Timecode
+		*out = new(Timecode)
+		**out = **in
+	}
+	if in.NormalPlayTime != nil {
+		in, out := &in.NormalPlayTime, &out.NormalPlayTime
+		*out = new(TimeValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OffsetNormalPlayTime != nil {
+		in, out := &in.OffsetNormalPlayTime, &out.OffsetNormalPlayTime
+		*out = new(DurationValue)
+		**out = **in
+	}
+	if in.EditUnitNumber != nil {
+		in, out := &in.EditUnitNumber, &out.EditUnitNumber
+		*out = new(EditUnitNumber)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(CustomTimeValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Time.
+func (in *Time) DeepCopy() *Time {
+	if in == nil {
+		return nil
+	}
+	out := new(Time)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeValue) DeepCopyInto(out *TimeValue) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeValue.
+func (in *TimeValue) DeepCopy() *TimeValue {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Timecode) DeepCopyInto(out *Timecode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Timecode.
+func (in *Timecode) DeepCopy() *Timecode {
+	if in == nil {
+		return nil
+	}
+	out := new(Timecode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimecodeFormat) DeepCopyInto(out *TimecodeFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.TimecodeStart != nil {
+		in, out := &in.TimecodeStart, &out.TimecodeStart
+		*out = make([]Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimecodeTrack != nil {
+		in, out := &in.TimecodeTrack, &out.TimecodeTrack
+		*out = make([]TimecodeTrack, len(*in))
+		copy(*out, *in)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimecodeFormat.
+func (in *TimecodeFormat) DeepCopy() *TimecodeFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(TimecodeFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimecodeFrame) DeepCopyInto(out *TimecodeFrame) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimecodeFrame.
+func (in *TimecodeFrame) DeepCopy() *TimecodeFrame {
+	if in == nil {
+		return nil
+	}
+	out := new(TimecodeFrame)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimecodeTrack) DeepCopyInto(out *TimecodeTrack) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimecodeTrack.
+func (in *TimecodeTrack) DeepCopy() *TimecodeTrack {
+	if in == nil {
+		return nil
+	}
+	out := new(TimecodeTrack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Title) DeepCopyInto(out *Title) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	in.DateAttributes.DeepCopyInto(&out.DateAttributes)
+	if in.DCTitle != nil {
+		in, out := &in.DCTitle, &out.DCTitle
+		*out = make([]dc.Title, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Title.
+func (in *Title) DeepCopy() *Title {
+	if in == nil {
+		return nil
+	}
+	out := new(Title)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Topic) DeepCopyInto(out *Topic) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.Attributor != nil {
+		in, out := &in.Attributor, &out.Attributor
+		*out = new(Entity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topic.
+func (in *Topic) DeepCopy() *Topic {
+	if in == nil {
+		return nil
+	}
+	out := new(Topic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Type) DeepCopyInto(out *Type) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	if in.DCType != nil {
+		in, out := &in.DCType, &out.DCType
+		*out = make([]dc.Type, len(*in))
+		copy(*out, *in)
+	}
+	if in.Genre != nil {
+		in, out := &in.Genre, &out.Genre
+		*out = make([]Genre, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectType != nil {
+		in, out := &in.ObjectType, &out.ObjectType
+		*out = make([]ObjectType, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetAudience != nil {
+		in, out := &in.TargetAudience, &out.TargetAudience
+		*out = make([]TargetAudience, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudienceLevel != nil {
+		in, out := &in.AudienceLevel, &out.AudienceLevel
+		*out = make([]AudienceLevel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContentFormat != nil {
+		in, out := &in.ContentFormat, &out.ContentFormat
+		*out = make([]ContentFormat, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Type.
+func (in *Type) DeepCopy() *Type {
+	if in == nil {
+		return nil
+	}
+	out := new(Type)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeAttributes) DeepCopyInto(out *TypeAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeAttributes.
+func (in *TypeAttributes) DeepCopy() *TypeAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UInt) DeepCopyInto(out *UInt) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UInt.
+func (in *UInt) DeepCopy() *UInt {
+	if in == nil {
+		return nil
+	}
+	out := new(UInt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UInt16) DeepCopyInto(out *UInt16) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UInt16.
+func (in *UInt16) DeepCopy() *UInt16 {
+	if in == nil {
+		return nil
+	}
+	out := new(UInt16)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UInt32) DeepCopyInto(out *UInt32) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UInt32.
+func (in *UInt32) DeepCopy() *UInt32 {
+	if in == nil {
+		return nil
+	}
+	out := new(UInt32)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UInt64) DeepCopyInto(out *UInt64) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UInt64.
+func (in *UInt64) DeepCopy() *UInt64 {
+	if in == nil {
+		return nil
+	}
+	out := new(UInt64)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UInt8) DeepCopyInto(out *UInt8) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UInt8.
+func (in *UInt8) DeepCopy() *UInt8 {
+	if in == nil {
+		return nil
+	}
+	out := new(UInt8)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *URIValue) DeepCopyInto(out *URIValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new URIValue.
+func (in *URIValue) DeepCopy() *URIValue {
+	if in == nil {
+		return nil
+	}
+	out := new(URIValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Version) DeepCopyInto(out *Version) {
+	*out = *in
+	out.Element = in.Element
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Version.
+func (in *Version) DeepCopy() *Version {
+	if in == nil {
+		return nil
+	}
+	out := new(Version)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoEncoding) DeepCopyInto(out *VideoEncoding) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoEncoding.
+func (in *VideoEncoding) DeepCopy() *VideoEncoding {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoEncoding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoFormat) DeepCopyInto(out *VideoFormat) {
+	*out = *in
+	in.TechnicalAttributes.DeepCopyInto(&out.TechnicalAttributes)
+	if in.RegionDelimX != nil {
+		in, out := &in.RegionDelimX, &out.RegionDelimX
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.RegionDelimY != nil {
+		in, out := &in.RegionDelimY, &out.RegionDelimY
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.Width != nil {
+		in, out := &in.Width, &out.Width
+		*out = make([]Width, len(*in))
+		copy(*out, *in)
+	}
+	if in.Height != nil {
+		in, out := &in.Height, &out.Height
+		*out = make([]Height, len(*in))
+		copy(*out, *in)
+	}
+	if in.Lines != nil {
+		in, out := &in.Lines, &out.Lines
+		*out = new(UInt)
+		**out = **in
+	}
+	if in.FrameRate != nil {
+		in, out := &in.FrameRate, &out.FrameRate
+		*out = new(Rational)
+		**out = **in
+	}
+	if in.AspectRatio != nil {
+		in, out := &in.AspectRatio, &out.AspectRatio
+		*out = make([]AspectRatio, len(*in))
+		copy(*out, *in)
+	}
+	if in.VideoEncoding != nil {
+		in, out := &in.VideoEncoding, &out.VideoEncoding
+		*out = new(VideoEncoding)
+		**out = **in
+	}
+	if in.Codec != nil {
+		in, out := &in.Codec, &out.Codec
+		*out = new(Codec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BitRate != nil {
+		in, out := &in.BitRate, &out.BitRate
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.BitRateMax != nil {
+		in, out := &in.BitRateMax, &out.BitRateMax
+		*out = new(Dimension)
+		**out = **in
+	}
+	if in.BitRateMode != nil {
+		in, out := &in.BitRateMode, &out.BitRateMode
+		*out = new(String)
+		**out = **in
+	}
+	if in.ScanningFormat != nil {
+		in, out := &in.ScanningFormat, &out.ScanningFormat
+		*out = new(String)
+		**out = **in
+	}
+	if in.ScanningOrder != nil {
+		in, out := &in.ScanningOrder, &out.ScanningOrder
+		*out = new(String)
+		**out = **in
+	}
+	if in.NoiseFilter != nil {
+		in, out := &in.NoiseFilter, &out.NoiseFilter
+		*out = new(NoiseFilter)
+		**out = **in
+	}
+	if in.VideoTrack != nil {
+		in, out := &in.VideoTrack, &out.VideoTrack
+		*out = make([]VideoTrack, len(*in))
+		copy(*out, *in)
+	}
+	if in.Flag3D != nil {
+		in, out := &in.Flag3D, &out.Flag3D
+		*out = new(Boolean)
+		**out = **in
+	}
+	if in.Flag360 != nil {
+		in, out := &in.Flag360, &out.Flag360
+		*out = new(Boolean)
+		**out = **in
+	}
+	if in.FlagMultiview != nil {
+		in, out := &in.FlagMultiview, &out.FlagMultiview
+		*out = new(Boolean)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MasteredColorVolume != nil {
+		in, out := &in.MasteredColorVolume, &out.MasteredColorVolume
+		*out = new(DigitalAssetColorVolume)
+		**out = **in
+	}
+	if in.LightLevel != nil {
+		in, out := &in.LightLevel, &out.LightLevel
+		*out = new(DigitalAssetVideoPictureLightLevel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IFrameInterval != nil {
+		in, out := &in.IFrameInterval, &out.IFrameInterval
+		*out = new(Int)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = make([]Comment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoFormat.
+func (in *VideoFormat) DeepCopy() *VideoFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoTrack) DeepCopyInto(out *VideoTrack) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoTrack.
+func (in *VideoTrack) DeepCopy() *VideoTrack {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoTrack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhitePointChromaticity) DeepCopyInto(out *WhitePointChromaticity) {
+	*out = *in
+	out.ChromaticityCIEx = in.ChromaticityCIEx
+	out.ChromaticityCIEy = in.ChromaticityCIEy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhitePointChromaticity.
+func (in *WhitePointChromaticity) DeepCopy() *WhitePointChromaticity {
+	if in == nil {
+		return nil
+	}
+	out := new(WhitePointChromaticity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Width) DeepCopyInto(out *Width) {
+	*out = *in
+	out.TypeAttributes = in.TypeAttributes
+	out.Dimension = in.Dimension
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Width.
+func (in *Width) DeepCopy() *Width {
+	if in == nil {
+		return nil
+	}
+	out := new(Width)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Zone) DeepCopyInto(out *Zone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Zone.
+func (in *Zone) DeepCopy() *Zone {
+	if in == nil {
+		return nil
+	}
+	out := new(Zone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneExclusion) DeepCopyInto(out *ZoneExclusion) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = make([]Zone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneExclusion.
+func (in *ZoneExclusion) DeepCopy() *ZoneExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
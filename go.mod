@@ -2,7 +2,4 @@ module github.com/nagare-media/models.go
 
 go 1.23
 
-require (
-	github.com/google/go-cmp v0.7.0
-	github.com/senseyeio/duration v0.0.0-20180430131211-7c2a214ada46
-)
+require github.com/google/go-cmp v0.7.0
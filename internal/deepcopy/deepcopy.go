@@ -0,0 +1,33 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deepcopy provides helpers for the hand-written deep-copy methods of the models.
+package deepcopy
+
+// Slice returns a copy of s with every element deep copied.
+func Slice[T any, PT interface {
+	*T
+	DeepCopyInto(*T)
+}](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, len(s))
+	for i := range s {
+		PT(&s[i]).DeepCopyInto(&out[i])
+	}
+	return out
+}
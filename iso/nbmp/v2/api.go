@@ -0,0 +1,439 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Operation is an operation of the NBMP REST APIs, i.e. the Workflow API, the Task API and the Function Discovery API.
+type Operation string
+
+const (
+	CreateWorkflowOperation = Operation("CreateWorkflow")
+	UpdateWorkflowOperation = Operation("UpdateWorkflow")
+	GetWorkflowOperation    = Operation("GetWorkflow")
+	DeleteWorkflowOperation = Operation("DeleteWorkflow")
+
+	CreateTaskOperation = Operation("CreateTask")
+	UpdateTaskOperation = Operation("UpdateTask")
+	GetTaskOperation    = Operation("GetTask")
+	DeleteTaskOperation = Operation("DeleteTask")
+
+	DiscoverFunctionsOperation = Operation("DiscoverFunctions")
+)
+
+// Paths of the API resources relative to the base URL of the API.
+const (
+	WorkflowsPath = "workflows"
+	TasksPath     = "tasks"
+	FunctionsPath = "functions"
+)
+
+// JSONMIMEType is the media type of responses that are not description documents, e.g. function discovery results.
+const JSONMIMEType = "application/json"
+
+// endpoint describes the HTTP binding of an operation.
+type endpoint struct {
+	method string
+	path   string
+
+	// the URL includes the resource ID
+	id bool
+
+	// the request includes a description document
+	body bool
+}
+
+var endpoints = map[Operation]endpoint{
+	CreateWorkflowOperation: {http.MethodPost, WorkflowsPath, false, true},
+	UpdateWorkflowOperation: {http.MethodPatch, WorkflowsPath, true, true},
+	GetWorkflowOperation:    {http.MethodGet, WorkflowsPath, true, false},
+	DeleteWorkflowOperation: {http.MethodDelete, WorkflowsPath, true, false},
+
+	CreateTaskOperation: {http.MethodPost, TasksPath, false, true},
+	UpdateTaskOperation: {http.MethodPatch, TasksPath, true, true},
+	GetTaskOperation:    {http.MethodGet, TasksPath, true, false},
+	DeleteTaskOperation: {http.MethodDelete, TasksPath, true, false},
+
+	DiscoverFunctionsOperation: {http.MethodGet, FunctionsPath, false, false},
+}
+
+// APIError is returned for responses with a status code other than 2xx.
+// +kubebuilder:object:generate=false
+type APIError struct {
+	StatusCode int
+
+	// Human readable description of the error.
+	// +optional
+	Message string
+}
+
+func (e *APIError) Error() string {
+	msg := strconv.Itoa(e.StatusCode) + " " + http.StatusText(e.StatusCode)
+	if e.Message == "" {
+		return msg
+	}
+	return msg + ": " + e.Message
+}
+
+// WorkflowRequest is a request of the Workflow API.
+type WorkflowRequest struct {
+	Operation Operation
+
+	// ID of the workflow. Defaults to the ID of the general descriptor of Workflow. Required for UpdateWorkflow,
+	// GetWorkflow and DeleteWorkflow.
+	// +optional
+	ID string
+
+	// Required for CreateWorkflow and UpdateWorkflow.
+	// +optional
+	Workflow *Workflow
+}
+
+// WorkflowResponse is a response of the Workflow API.
+type WorkflowResponse struct {
+	StatusCode int
+
+	// The workflow description document returned by the workflow manager. Its acknowledge descriptor indicates whether
+	// the request was fulfilled.
+	// +optional
+	Workflow *Workflow
+}
+
+// Validate checks that r has an operation of the Workflow API and all required fields for the operation.
+func (r *WorkflowRequest) Validate() error {
+	var doc *General
+	if r.Workflow != nil {
+		doc = &r.Workflow.General
+	}
+	if err := validateRequest(r.Operation, WorkflowsPath, r.ID, doc); err != nil {
+		return fmt.Errorf("WorkflowRequest.Validate: %w", err)
+	}
+	return nil
+}
+
+// HTTPRequest returns the HTTP request for r against the Workflow API at baseURL.
+func (r *WorkflowRequest) HTTPRequest(ctx context.Context, baseURL string) (*http.Request, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	var doc any
+	if r.Workflow != nil {
+		doc = r.Workflow
+	}
+	req, err := newHTTPRequest(ctx, baseURL, r.Operation, resourceID(r.ID, doc), nil, WorkflowDescriptionDocumentMIMEType, doc)
+	if err != nil {
+		return nil, fmt.Errorf("WorkflowRequest.HTTPRequest: %w", err)
+	}
+	return req, nil
+}
+
+// ReadWorkflowResponse reads a response of the Workflow API and closes its body. An APIError is returned for status
+// codes other than 2xx together with the response if the body is a workflow description document.
+func ReadWorkflowResponse(resp *http.Response) (*WorkflowResponse, error) {
+	r := &WorkflowResponse{StatusCode: resp.StatusCode}
+	var wf Workflow
+	ok, err := readResponse(resp, WorkflowDescriptionDocumentMIMEType, &wf)
+	if ok {
+		r.Workflow = &wf
+	}
+	if err != nil {
+		return r, fmt.Errorf("ReadWorkflowResponse: %w", err)
+	}
+	return r, nil
+}
+
+// TaskRequest is a request of the Task API.
+type TaskRequest struct {
+	Operation Operation
+
+	// ID of the task. Defaults to the ID of the general descriptor of Task. Required for UpdateTask, GetTask and
+	// DeleteTask.
+	// +optional
+	ID string
+
+	// Required for CreateTask and UpdateTask.
+	// +optional
+	Task *Task
+}
+
+// TaskResponse is a response of the Task API.
+type TaskResponse struct {
+	StatusCode int
+
+	// The task description document returned by the workflow manager or media processing entity. Its acknowledge
+	// descriptor indicates whether the request was fulfilled.
+	// +optional
+	Task *Task
+}
+
+// Validate checks that r has an operation of the Task API and all required fields for the operation.
+func (r *TaskRequest) Validate() error {
+	var doc *General
+	if r.Task != nil {
+		doc = &r.Task.General
+	}
+	if err := validateRequest(r.Operation, TasksPath, r.ID, doc); err != nil {
+		return fmt.Errorf("TaskRequest.Validate: %w", err)
+	}
+	return nil
+}
+
+// HTTPRequest returns the HTTP request for r against the Task API at baseURL.
+func (r *TaskRequest) HTTPRequest(ctx context.Context, baseURL string) (*http.Request, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	var doc any
+	if r.Task != nil {
+		doc = r.Task
+	}
+	req, err := newHTTPRequest(ctx, baseURL, r.Operation, resourceID(r.ID, doc), nil, TaskDescriptionDocumentMIMEType, doc)
+	if err != nil {
+		return nil, fmt.Errorf("TaskRequest.HTTPRequest: %w", err)
+	}
+	return req, nil
+}
+
+// ReadTaskResponse reads a response of the Task API and closes its body. An APIError is returned for status codes
+// other than 2xx together with the response if the body is a task description document.
+func ReadTaskResponse(resp *http.Response) (*TaskResponse, error) {
+	r := &TaskResponse{StatusCode: resp.StatusCode}
+	var t Task
+	ok, err := readResponse(resp, TaskDescriptionDocumentMIMEType, &t)
+	if ok {
+		r.Task = &t
+	}
+	if err != nil {
+		return r, fmt.Errorf("ReadTaskResponse: %w", err)
+	}
+	return r, nil
+}
+
+// FunctionDiscoveryRequest is a request of the Function Discovery API. Only functions matching all given fields are
+// returned.
+type FunctionDiscoveryRequest struct {
+	// +optional
+	ID string
+
+	// +optional
+	Name string
+
+	// keywords of the processing descriptor
+	// +optional
+	Keywords []string
+}
+
+// FunctionDiscoveryResponse is a response of the Function Discovery API.
+type FunctionDiscoveryResponse struct {
+	StatusCode int
+
+	// +optional
+	Functions []Function
+}
+
+// ParseFunctionDiscoveryRequest parses the query of a function discovery request.
+func ParseFunctionDiscoveryRequest(q url.Values) (*FunctionDiscoveryRequest, error) {
+	r := &FunctionDiscoveryRequest{Keywords: q["keyword"]}
+	for key, vals := range q {
+		switch key {
+		case "keyword":
+			continue
+		case "id", "name":
+		default:
+			return nil, fmt.Errorf("ParseFunctionDiscoveryRequest: illegal argument %q", key)
+		}
+		if len(vals) > 1 {
+			return nil, fmt.Errorf("ParseFunctionDiscoveryRequest: repeated argument %q", key)
+		}
+	}
+	r.ID = q.Get("id")
+	r.Name = q.Get("name")
+	return r, nil
+}
+
+// Query returns the fields of r as URL query.
+func (r *FunctionDiscoveryRequest) Query() url.Values {
+	q := url.Values{}
+	if r.ID != "" {
+		q.Set("id", r.ID)
+	}
+	if r.Name != "" {
+		q.Set("name", r.Name)
+	}
+	for _, k := range r.Keywords {
+		q.Add("keyword", k)
+	}
+	return q
+}
+
+// Matches reports whether fn matches r.
+func (r *FunctionDiscoveryRequest) Matches(fn *Function) bool {
+	if r.ID != "" && fn.General.ID != r.ID {
+		return false
+	}
+	if r.Name != "" && fn.General.Name != r.Name {
+		return false
+	}
+	for _, k := range r.Keywords {
+		if fn.Processing == nil || !slices.Contains(fn.Processing.Keywords, k) {
+			return false
+		}
+	}
+	return true
+}
+
+// HTTPRequest returns the HTTP request for r against the Function Discovery API at baseURL.
+func (r *FunctionDiscoveryRequest) HTTPRequest(ctx context.Context, baseURL string) (*http.Request, error) {
+	req, err := newHTTPRequest(ctx, baseURL, DiscoverFunctionsOperation, "", r.Query(), "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("FunctionDiscoveryRequest.HTTPRequest: %w", err)
+	}
+	return req, nil
+}
+
+// ReadFunctionDiscoveryResponse reads a response of the Function Discovery API and closes its body. An APIError is
+// returned for status codes other than 2xx.
+func ReadFunctionDiscoveryResponse(resp *http.Response) (*FunctionDiscoveryResponse, error) {
+	r := &FunctionDiscoveryResponse{StatusCode: resp.StatusCode}
+	if _, err := readResponse(resp, JSONMIMEType, &r.Functions); err != nil {
+		return r, fmt.Errorf("ReadFunctionDiscoveryResponse: %w", err)
+	}
+	return r, nil
+}
+
+// CheckContentType checks that the media type of the Content-Type header of h is mimeType or JSONMIMEType.
+func CheckContentType(h http.Header, mimeType string) error {
+	mt, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("CheckContentType: %w", err)
+	}
+	if mt != mimeType && mt != JSONMIMEType {
+		return fmt.Errorf("CheckContentType: unexpected media type %q", mt)
+	}
+	return nil
+}
+
+func validateRequest(op Operation, path, id string, doc *General) error {
+	e, ok := endpoints[op]
+	if !ok || e.path != path {
+		return fmt.Errorf("illegal operation %q", op)
+	}
+	if e.body && doc == nil {
+		return fmt.Errorf("%s requires a description document", op)
+	}
+	if !e.body && doc != nil {
+		return fmt.Errorf("%s does not allow a description document", op)
+	}
+	if doc != nil && id != "" && doc.ID != "" && doc.ID != id {
+		return fmt.Errorf("ID %q does not match ID %q of the description document", id, doc.ID)
+	}
+	if e.id && id == "" && (doc == nil || doc.ID == "") {
+		return fmt.Errorf("%s requires an ID", op)
+	}
+	return nil
+}
+
+// resourceID returns id or the ID of the general descriptor of doc.
+func resourceID(id string, doc any) string {
+	if id != "" {
+		return id
+	}
+	switch d := doc.(type) {
+	case *Workflow:
+		return d.General.ID
+	case *Task:
+		return d.General.ID
+	}
+	return ""
+}
+
+func newHTTPRequest(ctx context.Context, baseURL string, op Operation, id string, q url.Values, mimeType string, doc any) (*http.Request, error) {
+	e := endpoints[op]
+	elems := []string{e.path}
+	if e.id {
+		elems = append(elems, id)
+	}
+	u, err := url.JoinPath(baseURL, elems...)
+	if err != nil {
+		return nil, err
+	}
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	var body io.Reader
+	if doc != nil {
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, e.method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if doc != nil {
+		req.Header.Set("Content-Type", mimeType)
+	}
+	accept := mimeType
+	if accept == "" {
+		accept = JSONMIMEType
+	}
+	req.Header.Set("Accept", accept)
+	return req, nil
+}
+
+// readResponse decodes the body of resp into v if it has the media type mimeType or JSONMIMEType and reports whether
+// it was decoded. An APIError is returned for status codes other than 2xx.
+func readResponse(resp *http.Response, mimeType string, v any) (bool, error) {
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	ok := len(b) > 0 && CheckContentType(resp.Header, mimeType) == nil
+	if ok {
+		if err := json.Unmarshal(b, v); err != nil {
+			return false, err
+		}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &APIError{StatusCode: resp.StatusCode}
+		if !ok {
+			e.Message = strings.TrimSpace(string(b))
+		}
+		return ok, e
+	}
+	if !ok && len(b) > 0 {
+		return false, fmt.Errorf("unexpected content type %q", resp.Header.Get("Content-Type"))
+	}
+	return ok, nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/nagare-media/models.go/iso/nbmp/v2/internal/jsonschema"
+)
+
+// Resolve checks values, keyed by parameter name, against the parameters of c and returns the effective
+// configuration, i.e. the values of all valid parameters keyed by name. Values are normalized to bool, int64, float64,
+// string or []any (arrays decoded from JSON) depending on the datatype of the parameter.
+//
+// A value has to satisfy the restrictions of at least one value descriptor of its parameter. The IDs of the parameters
+// with a value and of the satisfied value descriptors exist; the conditions and exclusions of parameters are evaluated
+// against these IDs. Parameters without given value default to the only value allowed by their value descriptors, if
+// any. Defaulted parameters whose conditions or exclusions are not met are omitted, while given values that are not
+// valid are reported. Resolve returns FieldErrors with paths pointing to the violated parameter descriptors.
+func (c *Configuration) Resolve(values map[string]any) (map[string]any, error) {
+	var errs FieldErrors
+	errorf := func(i int, format string, args ...any) {
+		errs = append(errs, &FieldError{Path: index("/parameters", i), Message: fmt.Sprintf(format, args...)})
+	}
+
+	names := make(map[string]bool, len(c.Parameters))
+	for _, p := range c.Parameters {
+		names[p.Name] = true
+	}
+	unknown := make([]string, 0)
+	for name := range values {
+		if !names[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		errs = append(errs, &FieldError{Path: "/parameters", Message: fmt.Sprintf("unknown parameter %q", name)})
+	}
+
+	type setting struct {
+		index int
+		value any
+		given bool
+		ids   []int64
+	}
+	var settings []*setting
+
+	for i := range c.Parameters {
+		p := &c.Parameters[i]
+		s := &setting{index: i}
+		raw, given := values[p.Name]
+		if given {
+			v, err := p.normalize(raw)
+			if err != nil {
+				errorf(i, "invalid value for parameter %q: %s", p.Name, err)
+				continue
+			}
+			s.value, s.given = v, true
+		} else if v, ok := p.defaultValue(); ok {
+			s.value = v
+		} else {
+			continue
+		}
+
+		ok, ids, err := p.match(s.value)
+		switch {
+		case err != nil:
+			errorf(i, "parameter %q: %s", p.Name, err)
+			continue
+		case !ok && s.given:
+			errorf(i, "value %v of parameter %q does not satisfy its restrictions", s.value, p.Name)
+			continue
+		case !ok:
+			continue
+		}
+		s.ids = append(ids, p.ID)
+		settings = append(settings, s)
+	}
+
+	// omit parameters whose conditions or exclusions are not met until all remaining parameters are valid
+	for changed := true; changed; {
+		changed = false
+		exist := make(map[int64]bool)
+		for _, s := range settings {
+			for _, id := range s.ids {
+				exist[id] = true
+			}
+		}
+
+		settings = slices.DeleteFunc(settings, func(s *setting) bool {
+			p := &c.Parameters[s.index]
+			missing := slices.IndexFunc(p.Conditions, func(id int64) bool { return !exist[id] })
+			excluded := slices.IndexFunc(p.Exclusions, func(id int64) bool { return exist[id] })
+			if missing < 0 && excluded < 0 {
+				return false
+			}
+			changed = true
+			if s.given && missing >= 0 {
+				errorf(s.index, "parameter %q requires ID %d", p.Name, p.Conditions[missing])
+			} else if s.given {
+				errorf(s.index, "parameter %q is excluded by ID %d", p.Name, p.Exclusions[excluded])
+			}
+			return true
+		})
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	effective := make(map[string]any, len(settings))
+	for _, s := range settings {
+		effective[c.Parameters[s.index].Name] = s.value
+	}
+	return effective, nil
+}
+
+// normalize converts v to the Go type of the datatype of p.
+func (p *Parameter) normalize(v any) (any, error) {
+	switch p.Datatype {
+	case BooleanDatatype:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case IntegerDatatype:
+		if f, ok := toFloat(v); ok && f == math.Trunc(f) {
+			if n, ok := v.(json.Number); ok {
+				return n.Int64()
+			}
+			if rv := reflect.ValueOf(v); rv.CanInt() {
+				return rv.Int(), nil
+			} else if rv.CanUint() && rv.Uint() <= math.MaxInt64 {
+				return int64(rv.Uint()), nil
+			}
+			if f >= math.MinInt64 && f < math.MaxInt64 {
+				return int64(f), nil
+			}
+		}
+	case NumberDatatype:
+		if f, ok := toFloat(v); ok {
+			return f, nil
+		}
+	case StringDatatype:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case ArrayDatatype:
+		if k := reflect.ValueOf(v).Kind(); k == reflect.Slice || k == reflect.Array {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			var arr []any
+			if err := json.Unmarshal(b, &arr); err != nil {
+				return nil, err
+			}
+			return arr, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown datatype %q", p.Datatype)
+	}
+	return nil, fmt.Errorf("expected %s, got %T", p.Datatype, v)
+}
+
+func toFloat(v any) (float64, bool) {
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return float64(rv.Int()), true
+	case rv.CanUint():
+		return float64(rv.Uint()), true
+	case rv.CanFloat():
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// defaultValue returns the only value allowed by the value descriptors of p.
+func (p *Parameter) defaultValue() (any, bool) {
+	if len(p.Values) != 1 {
+		return nil, false
+	}
+	switch val := p.Values[0].(type) {
+	case *BooleanParameterValue:
+		return val.Restrictions, true
+	case *IntegerParameterValue:
+		r := val.Restrictions
+		if r.MinValue != nil && r.MaxValue != nil && *r.MinValue == *r.MaxValue {
+			return *r.MinValue, true
+		}
+	case *NumberParameterValue:
+		r := val.Restrictions
+		if r.MinValue != nil && r.MaxValue != nil && *r.MinValue == *r.MaxValue {
+			return *r.MinValue, true
+		}
+	case *StringParameterValue:
+		if len(val.Restrictions) == 1 {
+			return val.Restrictions[0], true
+		}
+	}
+	return nil, false
+}
+
+// match reports whether the normalized value v satisfies the restrictions of p and returns the IDs of the satisfied
+// value descriptors. Parameters without value descriptors accept all values. Nil value descriptors are ignored.
+func (p *Parameter) match(v any) (bool, []int64, error) {
+	for _, val := range p.Values {
+		if val == nil {
+			continue
+		}
+		if dt := valueDatatype(val); dt != p.Datatype {
+			return false, nil, fmt.Errorf("value %d has datatype %q instead of %q", val.GetID(), dt, p.Datatype)
+		}
+	}
+	if p.Datatype == ArrayDatatype && isJSONObject(p.Schema) {
+		ok, err := matchesSchema(p.Schema, v)
+		if err != nil {
+			return false, nil, fmt.Errorf("invalid schema: %w", err)
+		}
+		if !ok {
+			return false, nil, nil
+		}
+	}
+	if len(p.Values) == 0 {
+		return true, nil, nil
+	}
+
+	var ids []int64
+	for _, val := range p.Values {
+		ok := false
+		switch val := val.(type) {
+		case *BooleanParameterValue:
+			ok = v == val.Restrictions
+		case *IntegerParameterValue:
+			ok = val.Restrictions.match(v.(int64))
+		case *NumberParameterValue:
+			ok = val.Restrictions.match(v.(float64))
+		case *StringParameterValue:
+			ok = len(val.Restrictions) == 0 || slices.Contains(val.Restrictions, v.(string))
+		case *ArrayParameterValue:
+			var err error
+			ok = len(val.Restrictions) == 0
+			if !ok {
+				ok, err = matchesSchema(val.Restrictions, v)
+			}
+			if err != nil {
+				return false, nil, fmt.Errorf("invalid restrictions of value %d: %w", val.ID, err)
+			}
+		}
+		if ok {
+			ids = append(ids, val.GetID())
+		}
+	}
+	return len(ids) > 0, ids, nil
+}
+
+func (r *IntegerParameterValueRestrictions) match(v int64) bool {
+	if (r.MinValue != nil && v < *r.MinValue) || (r.MaxValue != nil && v > *r.MaxValue) {
+		return false
+	}
+	if r.Increment != nil && *r.Increment > 0 {
+		var base int64
+		if r.MinValue != nil {
+			base = *r.MinValue
+		}
+		return (v-base)%*r.Increment == 0
+	}
+	return true
+}
+
+func (r *NumberParameterValueRestrictions) match(v float64) bool {
+	if (r.MinValue != nil && v < *r.MinValue) || (r.MaxValue != nil && v > *r.MaxValue) {
+		return false
+	}
+	if r.Increment != nil && *r.Increment > 0 {
+		var base float64
+		if r.MinValue != nil {
+			base = *r.MinValue
+		}
+		steps := (v - base) / *r.Increment
+		return math.Abs(steps-math.Round(steps)) < 1e-9
+	}
+	return true
+}
+
+// isJSONObject reports whether r is a JSON object. The JSON schema of NBMP defines the schema of array parameters as
+// array, which is ignored.
+func isJSONObject(r RawJSON) bool {
+	b := bytes.TrimSpace(r)
+	return len(b) > 0 && b[0] == '{'
+}
+
+// matchesSchema reports whether v is valid against the JSON schema.
+func matchesSchema(schema RawJSON, v any) (bool, error) {
+	validator, err := jsonschema.Compile(schema)
+	if err != nil {
+		return false, err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return false, err
+	}
+	errs, err := validator.Validate("", b)
+	return len(errs) == 0, err
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+func readConfiguration(t *testing.T) *nbmp.Configuration {
+	t.Helper()
+	str, err := os.ReadFile("testdata/nbmp/example-parameter-representation.json")
+	if err != nil {
+		t.Fatalf("could not read file: %s", err)
+	}
+	cfg := &nbmp.Configuration{}
+	if err := json.Unmarshal(str, cfg); err != nil {
+		t.Fatalf("could not unmarshal configuration: %s", err)
+	}
+	return cfg
+}
+
+func TestParameterRoundTrip(t *testing.T) {
+	cfg := readConfiguration(t)
+	cfg.Parameters = append(cfg.Parameters, nbmp.Parameter{
+		Name:     "p7",
+		ID:       7,
+		Datatype: nbmp.ArrayDatatype,
+		Values: []nbmp.ParameterValue{
+			&nbmp.ArrayParameterValue{Name: "v71", ID: 71, Restrictions: nbmp.RawJSON(`{"maxItems":2}`)},
+		},
+	}, nbmp.Parameter{
+		Name:     "p8",
+		ID:       8,
+		Datatype: nbmp.BooleanDatatype,
+		Values:   []nbmp.ParameterValue{&nbmp.BooleanParameterValue{Name: "v81", ID: 81, Restrictions: true}},
+	})
+
+	str, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("marshal failed: %s", err)
+	}
+	got := &nbmp.Configuration{}
+	if err := json.Unmarshal(str, got); err != nil {
+		t.Fatalf("unmarshal failed: %s", err)
+	}
+	if diff := cmp.Diff(cfg, got); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+
+	p := nbmp.Parameter{
+		Name:     "p",
+		Datatype: nbmp.IntegerDatatype,
+		Values:   []nbmp.ParameterValue{&nbmp.StringParameterValue{ID: 1}},
+	}
+	if _, err := json.Marshal(p); err == nil {
+		t.Error("expected error for value with wrong datatype")
+	}
+}
+
+func TestConfigurationResolve(t *testing.T) {
+	cfg := readConfiguration(t)
+
+	tests := []struct {
+		values map[string]any
+		want   map[string]any
+		errs   nbmp.FieldErrors
+	}{
+		{
+			values: map[string]any{"p1": 5, "p3": "linear"},
+			want:   map[string]any{"p1": int64(5), "p3": "linear"},
+		},
+		{
+			values: map[string]any{"p1": json.Number("5"), "p2": 30.0, "p3": "linear", "p6": []int{1, 2, 3, 4}},
+			want:   map[string]any{"p1": int64(5), "p2": int64(30), "p3": "linear", "p6": []any{1.0, 2.0, 3.0, 4.0}},
+		},
+		{
+			values: map[string]any{"p1": 12, "p3": "linear"},
+			errs:   nbmp.FieldErrors{{Path: "/parameters/2", Message: `parameter "p3" requires ID 11`}},
+		},
+		{
+			values: map[string]any{"p1": 5, "p2": 30, "p3": "exponential", "p6": []int{1, 2, 3, 4}},
+			errs:   nbmp.FieldErrors{{Path: "/parameters/5", Message: `parameter "p6" is excluded by ID 32`}},
+		},
+		{
+			values: map[string]any{"p2": 30, "p6": []int{1, 2, 3}},
+			errs: nbmp.FieldErrors{
+				{Path: "/parameters/5", Message: `value [1 2 3] of parameter "p6" does not satisfy its restrictions`},
+			},
+		},
+		{
+			values: map[string]any{"p1": "5", "p2": 20, "p4": 1.5, "p9": true},
+			errs: nbmp.FieldErrors{
+				{Path: "/parameters", Message: `unknown parameter "p9"`},
+				{Path: "/parameters/0", Message: `invalid value for parameter "p1": expected integer, got string`},
+				{Path: "/parameters/1", Message: `value 20 of parameter "p2" does not satisfy its restrictions`},
+				{Path: "/parameters/3", Message: `invalid value for parameter "p4": unknown datatype "float"`},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		got, err := cfg.Resolve(tc.values)
+		if tc.errs != nil {
+			var errs nbmp.FieldErrors
+			if !errors.As(err, &errs) {
+				t.Errorf("%v: expected FieldErrors, got %v", tc.values, err)
+				continue
+			}
+			if diff := cmp.Diff(tc.errs, errs); diff != "" {
+				t.Errorf("%v: unexpected errors (-want +got):\n%s", tc.values, diff)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %s", tc.values, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("%v: unexpected configuration (-want +got):\n%s", tc.values, diff)
+		}
+	}
+}
+
+func TestConfigurationResolveDefaults(t *testing.T) {
+	cfg := &nbmp.Configuration{Parameters: []nbmp.Parameter{
+		{
+			Name:     "mode",
+			ID:       1,
+			Datatype: nbmp.StringDatatype,
+			Values:   []nbmp.ParameterValue{&nbmp.StringParameterValue{Name: "fast", ID: 11, Restrictions: []string{"fast"}}},
+		},
+		{
+			Name:       "quality",
+			ID:         2,
+			Datatype:   nbmp.IntegerDatatype,
+			Exclusions: []int64{11},
+			Values: []nbmp.ParameterValue{&nbmp.IntegerParameterValue{Name: "fixed", ID: 21,
+				Restrictions: nbmp.IntegerParameterValueRestrictions{MinValue: ptr[int64](5), MaxValue: ptr[int64](5)}}},
+		},
+		{
+			Name:     "step",
+			ID:       3,
+			Datatype: nbmp.NumberDatatype,
+			Values: []nbmp.ParameterValue{&nbmp.NumberParameterValue{Name: "halves", ID: 31,
+				Restrictions: nbmp.NumberParameterValueRestrictions{MinValue: ptr(0.0), Increment: ptr(0.5)}}},
+		},
+	}}
+
+	got, err := cfg.Resolve(map[string]any{"step": float32(1.5)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := map[string]any{"mode": "fast", "step": 1.5}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected configuration (-want +got):\n%s", diff)
+	}
+
+	if _, err := cfg.Resolve(map[string]any{"step": 1.25}); err == nil {
+		t.Error("expected error for value not matching the increment")
+	}
+}
+
+func TestConfigurationResolveArraySchemas(t *testing.T) {
+	tests := []struct {
+		schema nbmp.RawJSON
+		value  any
+		errs   nbmp.FieldErrors
+	}{
+		{
+			schema: nbmp.RawJSON(`{"items": {"properties": {"default": {"type": "string", "pattern": "^x$"}}}}`),
+			value:  []any{map[string]any{"default": "x"}},
+		},
+		{
+			schema: nbmp.RawJSON(`{"items": {"properties": {"default": {"type": "string", "pattern": "^x$"}}}}`),
+			value:  []any{map[string]any{"default": "y"}},
+			errs: nbmp.FieldErrors{
+				{Path: "/parameters/0", Message: `value [map[default:y]] of parameter "p" does not satisfy its restrictions`},
+			},
+		},
+		{
+			schema: nbmp.RawJSON(`{"allOf": [{"$ref": "#"}]}`),
+			value:  []any{1},
+			errs: nbmp.FieldErrors{{Path: "/parameters/0",
+				Message: `parameter "p": invalid schema: Validator.Validate: $ref "#" at #/allOf/0 recurses infinitely`}},
+		},
+	}
+
+	for _, tc := range tests {
+		cfg := &nbmp.Configuration{Parameters: []nbmp.Parameter{{Name: "p", Datatype: nbmp.ArrayDatatype, Schema: tc.schema}}}
+		_, err := cfg.Resolve(map[string]any{"p": tc.value})
+		if tc.errs == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.schema, err)
+			}
+			continue
+		}
+		var errs nbmp.FieldErrors
+		if !errors.As(err, &errs) {
+			t.Errorf("%s: expected FieldErrors, got %v", tc.schema, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.errs, errs); diff != "" {
+			t.Errorf("%s: unexpected errors (-want +got):\n%s", tc.schema, diff)
+		}
+	}
+}
+
+func TestConfigurationResolveDatatypeMismatch(t *testing.T) {
+	cfg := &nbmp.Configuration{Parameters: []nbmp.Parameter{{
+		Name:     "p",
+		Datatype: nbmp.StringDatatype,
+		Values:   []nbmp.ParameterValue{&nbmp.IntegerParameterValue{ID: 1}},
+	}}}
+
+	_, err := cfg.Resolve(map[string]any{"p": "x"})
+	want := nbmp.FieldErrors{{Path: "/parameters/0", Message: `parameter "p": value 1 has datatype "integer" instead of "string"`}}
+	var errs nbmp.FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected FieldErrors, got %v", err)
+	}
+	if diff := cmp.Diff(want, errs); diff != "" {
+		t.Errorf("unexpected errors (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfigurationResolveNilValue(t *testing.T) {
+	p := nbmp.Parameter{
+		Name:     "p",
+		Datatype: nbmp.StringDatatype,
+		Values:   []nbmp.ParameterValue{nil, &nbmp.StringParameterValue{ID: 1, Restrictions: []string{"x"}}},
+	}
+	cfg := &nbmp.Configuration{Parameters: []nbmp.Parameter{p}}
+
+	got, err := cfg.Resolve(map[string]any{"p": "x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(map[string]any{"p": "x"}, got); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := `{"name":"p","id":0,"datatype":"string","values":[null,{"name":"","id":1,"restrictions":["x"]}]}`
+	if string(b) != want {
+		t.Errorf("unexpected JSON:\ngot:  %s\nwant: %s", b, want)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"slices"
+
+	"github.com/nagare-media/models.go/internal/deepcopy"
+)
+
+// Hand-written DeepCopyInto for types with time.Time or interface fields, which the generator cannot copy.
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *General) DeepCopyInto(out *General) {
+	*out = *in
+	out.Rank = copyPtr(in.Rank)
+	out.NBMPBrand = copyPtr(in.NBMPBrand)
+	out.PublishedTime = copyPtr(in.PublishedTime)
+	out.Priority = copyPtr(in.Priority)
+	out.Location = copyPtr(in.Location)
+	out.TaskGroup = deepcopy.Slice(in.TaskGroup)
+	out.InputPorts = deepcopy.Slice(in.InputPorts)
+	out.OutputPorts = deepcopy.Slice(in.OutputPorts)
+	out.IsGroup = copyPtr(in.IsGroup)
+	out.Nonessential = copyPtr(in.Nonessential)
+	out.State = copyPtr(in.State)
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Processing) DeepCopyInto(out *Processing) {
+	*out = *in
+	out.Keywords = slices.Clone(in.Keywords)
+	out.Image = deepcopy.Slice(in.Image)
+	out.StartTime = copyPtr(in.StartTime)
+	out.ConnectionMap = deepcopy.Slice(in.ConnectionMap)
+	out.FunctionRestrictions = deepcopy.Slice(in.FunctionRestrictions)
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Parameter) DeepCopyInto(out *Parameter) {
+	*out = *in
+	out.Description = copyPtr(in.Description)
+	out.Conditions = slices.Clone(in.Conditions)
+	out.Exclusions = slices.Clone(in.Exclusions)
+	if in.Values != nil {
+		out.Values = make([]ParameterValue, len(in.Values))
+		for i, v := range in.Values {
+			if v != nil {
+				out.Values[i] = v.DeepCopyParameterValue()
+			}
+		}
+	}
+	out.Schema = in.Schema.DeepCopy()
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Reporting) DeepCopyInto(out *Reporting) {
+	*out = *in
+	out.Event = deepcopy.Slice(in.Event)
+	out.Variable = deepcopy.Slice(in.Variable)
+	out.SystemEvents = deepcopy.Slice(in.SystemEvents)
+	out.SystemVariables = deepcopy.Slice(in.SystemVariables)
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	out.Event = deepcopy.Slice(in.Event)
+	out.Variable = deepcopy.Slice(in.Variable)
+	out.SystemEvents = deepcopy.Slice(in.SystemEvents)
+	out.SystemVariables = deepcopy.Slice(in.SystemVariables)
+	out.NotificationType = slices.Clone(in.NotificationType)
+	out.URLs = slices.Clone(in.URLs)
+	out.NotificationInterval = copyPtr(in.NotificationInterval)
+}
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Security) DeepCopyInto(out *Security) {
+	*out = *in
+	out.Scope = copyPtr(in.Scope)
+	out.AuthorityURL = copyPtr(in.AuthorityURL)
+	out.Certificate = copyPtr(in.Certificate)
+	out.AuthToken = copyPtr(in.AuthToken)
+	out.ClientGrants = copyPtr(in.ClientGrants)
+	out.AuthTokenExpires = copyPtr(in.AuthTokenExpires)
+	out.AuthTokenRenew = copyPtr(in.AuthTokenRenew)
+	out.AuthTokenRotation = copyPtr(in.AuthTokenRotation)
+}
+
+// copyPtr returns a pointer to a copy of *p. T must not contain references, except for time.Time which is immutable.
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+func newTask() *nbmp.Task {
+	return &nbmp.Task{
+		General: nbmp.General{
+			ID:            "watermark",
+			PublishedTime: ptr(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)),
+			InputPorts:    []nbmp.Port{{PortName: "in", Bind: &nbmp.PortBinding{StreamID: "input"}}},
+		},
+		Input: nbmp.Input{
+			MediaParameters: []nbmp.MediaParameter{{StreamID: "input", Keywords: []string{"video"}}},
+		},
+		Configuration: &nbmp.Configuration{
+			Parameters: []nbmp.Parameter{{
+				Name:     "text",
+				Datatype: nbmp.StringDatatype,
+				Values: []nbmp.ParameterValue{
+					&nbmp.StringParameterValue{Name: "text", Restrictions: []string{"nagare"}},
+					nil,
+				},
+				Schema: nbmp.RawJSON(`{"enum":["nagare","media"]}`),
+			}},
+		},
+		ClientAssistant: &nbmp.ClientAssistant{
+			MeasurementCollectionList: nbmp.RawJSON(`{"viewport":{"frequency":1}}`),
+		},
+		Monitoring: &nbmp.Monitoring{
+			SystemEvents: []nbmp.RawJSON{nbmp.RawJSON(`{"name":"start"}`)},
+		},
+		Security: &nbmp.Security{
+			AuthTokenExpires: ptr(time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)),
+		},
+	}
+}
+
+func TestDeepCopyTask(t *testing.T) {
+	orig := newTask()
+	cp := orig.DeepCopy()
+	if diff := cmp.Diff(orig, cp); diff != "" {
+		t.Fatalf("copy differs (-want +got):\n%s", diff)
+	}
+
+	*cp.General.PublishedTime = time.Time{}
+	cp.General.InputPorts[0].Bind.StreamID = "changed"
+	cp.Input.MediaParameters[0].Keywords[0] = "changed"
+	p := &cp.Configuration.Parameters[0]
+	p.Values[0].SetName("changed")
+	p.Values[0].(*nbmp.StringParameterValue).Restrictions[0] = "changed"
+	p.Schema[1] = 'x'
+	cp.ClientAssistant.MeasurementCollectionList[1] = 'x'
+	cp.Monitoring.SystemEvents[0][1] = 'x'
+	*cp.Security.AuthTokenExpires = time.Time{}
+
+	if diff := cmp.Diff(newTask(), orig); diff != "" {
+		t.Errorf("modifying the copy modified the original (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeepCopyInterfaces(t *testing.T) {
+	var io nbmp.InputOrOutput = &nbmp.Output{MetadataParameters: []nbmp.MetadataParameter{{StreamID: "report"}}}
+	cp := io.DeepCopyInputOrOutput()
+	cp.GetMetadataParameters()[0].StreamID = "changed"
+	if io.GetMetadataParameters()[0].StreamID != "report" {
+		t.Error("modifying the copy of an InputOrOutput modified the original")
+	}
+
+	var mp nbmp.MediaOrMetadataParameter = &nbmp.MediaParameter{Keywords: []string{"video"}}
+	mp.DeepCopyMediaOrMetadataParameter().GetKeywords()[0] = "changed"
+	if mp.GetKeywords()[0] != "video" {
+		t.Error("modifying the copy of a MediaOrMetadataParameter modified the original")
+	}
+}
+
+func TestDeepCopyWorkflows(t *testing.T) {
+	files := []string{
+		"testdata/nagare/v2_nbmp_live.wdd",
+		"testdata/nagare/v2_nbmp_vod.wdd",
+	}
+
+	for _, file := range files {
+		str, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("could not read file %s: %s", file, err)
+		}
+
+		wd := &nbmp.Workflow{}
+		if err := json.NewDecoder(bytes.NewReader(str)).Decode(wd); err != nil {
+			t.Fatalf("could not unmarshal workflow: %s", err)
+		}
+		if diff := cmp.Diff(wd, wd.DeepCopy()); diff != "" {
+			t.Errorf("%s: copy differs (-want +got):\n%s", file, diff)
+		}
+	}
+}
@@ -19,4 +19,6 @@ limitations under the License.
 //
 // See https://www.iso.org/standard/83651.html
 // See https://github.com/MPEGGroup/NBMP
+//
+// +kubebuilder:object:generate=true
 package v2
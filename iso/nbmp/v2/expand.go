@@ -0,0 +1,218 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Expander derives the task description documents of a workflow by resolving the functions referenced by the
+// connection map from function repositories.
+//
+// The repositories listed in the repository descriptor of the workflow are opened with Open and searched in order.
+// Depending on the repository mode, the Default repository is searched afterwards:
+//
+//   - strict: only the listed repositories are used.
+//   - preferred: the listed repositories are used first, then Default.
+//   - available (default): the listed repositories are used first, then Default. Listed repositories that cannot be
+//     opened or searched are skipped.
+//
+// Workflows without repository descriptor only use Default.
+// +kubebuilder:object:generate=false
+type Expander struct {
+	// Open returns the function repository of a repository location, e.g. OpenFileFunctionRepository.
+	// +optional
+	Open func(ctx context.Context, loc RepositoryLocation) (FunctionRepository, error)
+
+	// +optional
+	Default FunctionRepository
+}
+
+// Expand returns one task per function instance of the connection map of wf in topological order. Tasks are created
+// with NewTaskFromFunction using the function restriction of the instance. Function groups are flattened first (see
+// Flatten). The task ID is the instance. Ports are bound according to the connection map: the input port of a
+// connection is bound to the stream of its output port. Expand returns a CycleError if the connection map is not acyclic.
+func (e *Expander) Expand(ctx context.Context, wf *Workflow) ([]*Task, error) {
+	repos, err := e.repositories(ctx, wf.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("Expander.Expand: %w", err)
+	}
+	p, err := flatten(ctx, repos, &wf.Processing, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Expander.Expand: %w", err)
+	}
+
+	order, err := p.Graph().TopologicalOrder()
+	if err != nil {
+		return nil, fmt.Errorf("Expander.Expand: %w", err)
+	}
+	functionIDs, _, err := instanceFunctions(p, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Expander.Expand: %w", err)
+	}
+	restrictions := make(map[string]*FunctionRestriction)
+	for i := range p.FunctionRestrictions {
+		fr := &p.FunctionRestrictions[i]
+		restrictions[fr.Instance] = fr
+	}
+
+	tasks := make(map[string]*Task, len(order))
+	result := make([]*Task, 0, len(order))
+	for _, instance := range order {
+		id, ok := functionIDs[instance]
+		if !ok {
+			return nil, fmt.Errorf("Expander.Expand: instance %q is not part of the connection map", instance)
+		}
+		fn, err := repos.function(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("Expander.Expand: instance %q: %w", instance, err)
+		}
+
+		opts := []TaskOption{WithInstance(instance)}
+		if fr, ok := restrictions[instance]; ok {
+			opts = append(opts, WithFunctionRestriction(fr))
+		}
+		t, err := NewTaskFromFunction(fn, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("Expander.Expand: instance %q: %w", instance, err)
+		}
+		tasks[instance] = t
+		result = append(result, t)
+	}
+
+	for _, cm := range p.ConnectionMap {
+		if err := bindConnection(tasks[cm.From.Instance], tasks[cm.To.Instance], &cm); err != nil {
+			return nil, fmt.Errorf("Expander.Expand: connection %q: %w", cm.ConnectionID, err)
+		}
+	}
+	return result, nil
+}
+
+// bindConnection binds the input port of to to the stream of the output port of from.
+func bindConnection(from, to *Task, cm *ConnectionMapping) error {
+	out := findPort(from.General.OutputPorts, cm.From.PortName)
+	if out == nil || out.Bind == nil {
+		return fmt.Errorf("unknown or unbound output port %q of instance %q", cm.From.PortName, cm.From.Instance)
+	}
+	in := findPort(to.General.InputPorts, cm.To.PortName)
+	if in == nil {
+		return fmt.Errorf("unknown input port %q of instance %q", cm.To.PortName, cm.To.Instance)
+	}
+
+	streamID := out.Bind.StreamID
+	if in.Bind == nil {
+		in.Bind = &PortBinding{}
+	}
+	old := in.Bind.StreamID
+	in.Bind.StreamID = streamID
+
+	mps := to.Input.GetMediaParameters()
+	for i := range mps {
+		if mps[i].StreamID == old {
+			mps[i].StreamID = streamID
+		}
+	}
+	mdps := to.Input.GetMetadataParameters()
+	for i := range mdps {
+		if mdps[i].StreamID == old {
+			mdps[i].StreamID = streamID
+		}
+	}
+	return nil
+}
+
+func findPort(ports []Port, name string) *Port {
+	for i := range ports {
+		if ports[i].PortName == name {
+			return &ports[i]
+		}
+	}
+	return nil
+}
+
+// repositoryChain searches repositories in order.
+type repositoryChain struct {
+	repos []FunctionRepository
+
+	// skip errors other than ErrFunctionNotFound of the first n repositories
+	lenient int
+
+	// functions already resolved by ID
+	cache map[string]*Function
+}
+
+func (e *Expander) repositories(ctx context.Context, r *Repository) (*repositoryChain, error) {
+	c := &repositoryChain{cache: make(map[string]*Function)}
+	mode := AvailableRepositoryMode
+	if r != nil && r.Mode != nil {
+		mode = *r.Mode
+	}
+
+	if r != nil {
+		for _, loc := range r.Location {
+			if e.Open == nil {
+				if mode == AvailableRepositoryMode {
+					continue
+				}
+				return nil, fmt.Errorf("cannot open repository %q", loc.Name)
+			}
+			repo, err := e.Open(ctx, loc)
+			if err != nil && mode == AvailableRepositoryMode {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("repository %q: %w", loc.Name, err)
+			}
+			c.repos = append(c.repos, repo)
+		}
+		if mode == AvailableRepositoryMode {
+			c.lenient = len(c.repos)
+		}
+	}
+
+	switch mode {
+	case StrictRepositoryMode:
+	case PreferredRepositoryMode, AvailableRepositoryMode:
+		if e.Default != nil {
+			c.repos = append(c.repos, e.Default)
+		}
+	default:
+		return nil, fmt.Errorf("unknown repository mode %q", mode)
+	}
+	return c, nil
+}
+
+func (c *repositoryChain) function(ctx context.Context, id string) (*Function, error) {
+	if fn, ok := c.cache[id]; ok {
+		return fn, nil
+	}
+	for i, repo := range c.repos {
+		fn, err := repo.Function(ctx, id)
+		switch {
+		case err == nil:
+			c.cache[id] = fn
+			return fn, nil
+		case errors.Is(err, ErrFunctionNotFound), i < c.lenient:
+			continue
+		default:
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrFunctionNotFound, id)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/base"
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+const (
+	transcodeFunctionID = "urn:example:function:transcode"
+	packageFunctionID   = "urn:example:function:package"
+)
+
+func newPackagingWorkflow(mode nbmp.RepositoryMode) *nbmp.Workflow {
+	port := func(function, instance, name string) nbmp.ConnectionMappingPort {
+		return nbmp.ConnectionMappingPort{ID: function, Instance: instance, PortName: name}
+	}
+	return &nbmp.Workflow{
+		General: nbmp.General{ID: "wf"},
+		Repository: &nbmp.Repository{
+			Mode:     &mode,
+			Location: []nbmp.RepositoryLocation{{URL: base.URI("file://testdata/repository"), Name: "local"}},
+		},
+		Processing: nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				{
+					ConnectionID: "hd-package",
+					From:         port(transcodeFunctionID, "transcode-hd", "out"),
+					To:           port(packageFunctionID, "package", "video-1"),
+				},
+				{
+					ConnectionID: "sd-package",
+					From:         port(transcodeFunctionID, "transcode-sd", "out"),
+					To:           port(packageFunctionID, "package", "video-2"),
+				},
+			},
+			FunctionRestrictions: []nbmp.FunctionRestriction{{
+				Instance: "transcode-sd",
+				General:  &nbmp.General{Description: "Transcodes to SD"},
+			}},
+		},
+	}
+}
+
+// openTestRepository opens file URLs relative to the working directory.
+func openTestRepository(ctx context.Context, loc nbmp.RepositoryLocation) (nbmp.FunctionRepository, error) {
+	if loc.URL != "file://testdata/repository" {
+		return nil, errors.New("unknown repository")
+	}
+	return nbmp.NewFSFunctionRepository(os.DirFS("testdata/repository"))
+}
+
+func TestExpand(t *testing.T) {
+	e := &nbmp.Expander{Open: openTestRepository}
+	tasks, err := e.Expand(context.Background(), newPackagingWorkflow(nbmp.StrictRepositoryMode))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.General.ID)
+	}
+	if diff := cmp.Diff([]string{"transcode-hd", "transcode-sd", "package"}, ids); diff != "" {
+		t.Fatalf("unexpected tasks (-want +got):\n%s", diff)
+	}
+
+	if d := tasks[1].General.Description; d != "Transcodes to SD" {
+		t.Errorf("function restriction not applied: %q", d)
+	}
+
+	pkg := tasks[2]
+	wantPorts := []nbmp.Port{
+		{PortName: "video-1", Bind: &nbmp.PortBinding{StreamID: "transcode-hd.video-out"}},
+		{PortName: "video-2", Bind: &nbmp.PortBinding{StreamID: "transcode-sd.video-out"}},
+	}
+	if diff := cmp.Diff(wantPorts, pkg.General.InputPorts); diff != "" {
+		t.Errorf("unexpected input ports (-want +got):\n%s", diff)
+	}
+	for i, mp := range pkg.Input.MediaParameters {
+		if mp.StreamID != wantPorts[i].Bind.StreamID {
+			t.Errorf("input %d: unexpected stream ID %q", i, mp.StreamID)
+		}
+	}
+	if id := pkg.Output.MediaParameters[0].StreamID; id != "package.dash" {
+		t.Errorf("unexpected output stream ID %q", id)
+	}
+}
+
+func TestExpandRepositoryModes(t *testing.T) {
+	defaultRepo := nbmp.MemoryFunctionRepository{}
+	fs, err := nbmp.NewFSFunctionRepository(os.DirFS("testdata/repository"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for id, fn := range fs {
+		defaultRepo[id] = fn
+	}
+
+	failingOpen := func(ctx context.Context, loc nbmp.RepositoryLocation) (nbmp.FunctionRepository, error) {
+		return nil, errors.New("unreachable")
+	}
+	emptyOpen := func(ctx context.Context, loc nbmp.RepositoryLocation) (nbmp.FunctionRepository, error) {
+		return nbmp.MemoryFunctionRepository{}, nil
+	}
+
+	tests := []struct {
+		name    string
+		mode    nbmp.RepositoryMode
+		e       *nbmp.Expander
+		wantErr error
+	}{
+		{"strict without listed function", nbmp.StrictRepositoryMode,
+			&nbmp.Expander{Open: emptyOpen, Default: defaultRepo}, nbmp.ErrFunctionNotFound},
+		{"preferred falls back to default", nbmp.PreferredRepositoryMode,
+			&nbmp.Expander{Open: emptyOpen, Default: defaultRepo}, nil},
+		{"preferred with unreachable repository", nbmp.PreferredRepositoryMode,
+			&nbmp.Expander{Open: failingOpen, Default: defaultRepo}, errors.New("unreachable")},
+		{"available skips unreachable repository", nbmp.AvailableRepositoryMode,
+			&nbmp.Expander{Open: failingOpen, Default: defaultRepo}, nil},
+		{"available without default", nbmp.AvailableRepositoryMode,
+			&nbmp.Expander{Open: emptyOpen}, nbmp.ErrFunctionNotFound},
+	}
+
+	for _, tc := range tests {
+		_, err := tc.e.Expand(context.Background(), newPackagingWorkflow(tc.mode))
+		switch {
+		case tc.wantErr == nil && err != nil:
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		case tc.wantErr != nil && err == nil:
+			t.Errorf("%s: expected error", tc.name)
+		case errors.Is(tc.wantErr, nbmp.ErrFunctionNotFound) && !errors.Is(err, nbmp.ErrFunctionNotFound):
+			t.Errorf("%s: expected ErrFunctionNotFound, got %s", tc.name, err)
+		}
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	e := &nbmp.Expander{Open: openTestRepository}
+
+	wf := newPackagingWorkflow(nbmp.StrictRepositoryMode)
+	wf.Processing.ConnectionMap[1].To.PortName = "video-3"
+	if _, err := e.Expand(context.Background(), wf); err == nil {
+		t.Error("expected error for unknown port")
+	}
+
+	wf = newPackagingWorkflow(nbmp.StrictRepositoryMode)
+	wf.Processing.ConnectionMap[1].From.ID = packageFunctionID
+	if _, err := e.Expand(context.Background(), wf); err == nil {
+		t.Error("expected error for instance with different functions")
+	}
+
+	wf = newPackagingWorkflow(nbmp.StrictRepositoryMode)
+	wf.Processing.ConnectionMap = append(wf.Processing.ConnectionMap, nbmp.ConnectionMapping{
+		ConnectionID: "loop",
+		From:         wf.Processing.ConnectionMap[0].To,
+		To:           wf.Processing.ConnectionMap[0].From,
+	})
+	var cycleErr *nbmp.CycleError
+	if _, err := e.Expand(context.Background(), wf); !errors.As(err, &cycleErr) {
+		t.Errorf("expected CycleError, got %v", err)
+	}
+}
+
+func TestOpenFileFunctionRepository(t *testing.T) {
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := nbmp.OpenFileFunctionRepository(context.Background(),
+		nbmp.RepositoryLocation{URL: base.URI("file://" + dir + "/testdata/repository")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	fn, err := repo.Function(context.Background(), packageFunctionID)
+	if err != nil || fn.General.Name != "package" {
+		t.Errorf("unexpected function %v: %v", fn, err)
+	}
+	if _, err := repo.Function(context.Background(), "missing"); !errors.Is(err, nbmp.ErrFunctionNotFound) {
+		t.Errorf("expected ErrFunctionNotFound, got %v", err)
+	}
+}
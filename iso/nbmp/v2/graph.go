@@ -0,0 +1,291 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"slices"
+	"strings"
+)
+
+// Graph is the directed graph described by the connection map of a processing descriptor. Nodes are function
+// instances, edges are connections identified by their connection-id. Nodes are ordered by their first appearance in
+// the connection map followed by instances that only appear in the function restrictions.
+//
+// A Graph is a snapshot; later changes of the processing descriptor are not reflected.
+// +kubebuilder:object:generate=false
+type Graph struct {
+	nodes []string
+	edges []ConnectionMapping
+
+	// edge indices by instance
+	in, out map[string][]int
+
+	// declared ports by instance
+	inputPorts, outputPorts map[string][]Port
+}
+
+// InstancePort is a port of a function instance.
+type InstancePort struct {
+	Instance string
+	PortName string
+
+	// Output is true for output ports and false for input ports.
+	Output bool
+}
+
+func (p InstancePort) String() string {
+	return p.Instance + "." + p.PortName
+}
+
+// CycleError is returned if a graph is not acyclic.
+// +kubebuilder:object:generate=false
+type CycleError struct {
+	// Instances forming the cycle. The last instance is connected to the first.
+	Instances []string
+}
+
+func (e *CycleError) Error() string {
+	if len(e.Instances) == 0 {
+		return "connection map has cycle"
+	}
+	return "connection map has cycle: " + strings.Join(append(slices.Clone(e.Instances), e.Instances[0]), " -> ")
+}
+
+// Graph returns the graph described by the connection map of p. Ports of instances are taken from the general
+// descriptors of the function restrictions.
+func (p *Processing) Graph() *Graph {
+	g := &Graph{
+		edges:       slices.Clone(p.ConnectionMap),
+		in:          make(map[string][]int),
+		out:         make(map[string][]int),
+		inputPorts:  make(map[string][]Port),
+		outputPorts: make(map[string][]Port),
+	}
+
+	known := make(map[string]bool)
+	addNode := func(instance string) {
+		if !known[instance] {
+			known[instance] = true
+			g.nodes = append(g.nodes, instance)
+		}
+	}
+	for i, cm := range g.edges {
+		addNode(cm.From.Instance)
+		addNode(cm.To.Instance)
+		g.out[cm.From.Instance] = append(g.out[cm.From.Instance], i)
+		g.in[cm.To.Instance] = append(g.in[cm.To.Instance], i)
+	}
+	for _, fr := range p.FunctionRestrictions {
+		addNode(fr.Instance)
+		if fr.General != nil {
+			g.inputPorts[fr.Instance] = fr.General.InputPorts
+			g.outputPorts[fr.Instance] = fr.General.OutputPorts
+		}
+	}
+	return g
+}
+
+// Nodes returns the function instances.
+func (g *Graph) Nodes() []string {
+	return slices.Clone(g.nodes)
+}
+
+// Edges returns the connection IDs in the order of the connection map.
+func (g *Graph) Edges() []string {
+	ids := make([]string, len(g.edges))
+	for i, cm := range g.edges {
+		ids[i] = cm.ConnectionID
+	}
+	return ids
+}
+
+// Edge returns the connection-map entry with the given connection ID.
+func (g *Graph) Edge(id string) (ConnectionMapping, bool) {
+	for _, cm := range g.edges {
+		if cm.ConnectionID == id {
+			return cm, true
+		}
+	}
+	return ConnectionMapping{}, false
+}
+
+// InEdges returns the IDs of the connections ending at instance.
+func (g *Graph) InEdges(instance string) []string {
+	return g.edgeIDs(g.in[instance])
+}
+
+// OutEdges returns the IDs of the connections starting at instance.
+func (g *Graph) OutEdges(instance string) []string {
+	return g.edgeIDs(g.out[instance])
+}
+
+func (g *Graph) edgeIDs(idx []int) []string {
+	ids := make([]string, len(idx))
+	for i, e := range idx {
+		ids[i] = g.edges[e].ConnectionID
+	}
+	return ids
+}
+
+// Sources returns the instances without incoming connections.
+func (g *Graph) Sources() []string {
+	return slices.DeleteFunc(g.Nodes(), func(n string) bool { return len(g.in[n]) > 0 })
+}
+
+// Sinks returns the instances without outgoing connections.
+func (g *Graph) Sinks() []string {
+	return slices.DeleteFunc(g.Nodes(), func(n string) bool { return len(g.out[n]) > 0 })
+}
+
+// Upstream returns the instances from which instance can be reached, i.e. its transitive predecessors, in node order.
+// instance itself is only included if it is part of a cycle.
+func (g *Graph) Upstream(instance string) []string {
+	return g.closure(instance, func(e int) string { return g.edges[e].From.Instance }, g.in)
+}
+
+// Downstream returns the instances that can be reached from instance, i.e. its transitive successors, in node order.
+// instance itself is only included if it is part of a cycle.
+func (g *Graph) Downstream(instance string) []string {
+	return g.closure(instance, func(e int) string { return g.edges[e].To.Instance }, g.out)
+}
+
+func (g *Graph) closure(instance string, next func(int) string, edges map[string][]int) []string {
+	reached := make(map[string]bool)
+	queue := []string{instance}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, e := range edges[n] {
+			m := next(e)
+			if !reached[m] {
+				reached[m] = true
+				queue = append(queue, m)
+			}
+		}
+	}
+	return slices.DeleteFunc(g.Nodes(), func(n string) bool { return !reached[n] })
+}
+
+// Cycle returns the instances of a cycle of g or nil if g is acyclic. The last instance is connected to the first.
+func (g *Graph) Cycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(g.nodes))
+	var path []string
+
+	var visit func(n string) []string
+	visit = func(n string) []string {
+		state[n] = visiting
+		path = append(path, n)
+		for _, e := range g.out[n] {
+			m := g.edges[e].To.Instance
+			switch state[m] {
+			case visiting:
+				return slices.Clone(path[slices.Index(path, m):])
+			case unvisited:
+				if c := visit(m); c != nil {
+					return c
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[n] = visited
+		return nil
+	}
+
+	for _, n := range g.nodes {
+		if state[n] == unvisited {
+			if c := visit(n); c != nil {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// TopologicalOrder returns the instances such that every instance comes after all its predecessors. Independent
+// instances keep their node order. It returns a CycleError if g is not acyclic.
+func (g *Graph) TopologicalOrder() ([]string, error) {
+	if c := g.Cycle(); c != nil {
+		return nil, &CycleError{Instances: c}
+	}
+
+	inDegree := make(map[string]int, len(g.nodes))
+	for _, n := range g.nodes {
+		inDegree[n] = len(g.in[n])
+	}
+
+	order := make([]string, 0, len(g.nodes))
+	done := make(map[string]bool, len(g.nodes))
+	for len(order) < len(g.nodes) {
+		// pick the first ready instance in node order to keep the order deterministic
+		for _, n := range g.nodes {
+			if done[n] || inDegree[n] > 0 {
+				continue
+			}
+			done[n] = true
+			order = append(order, n)
+			for _, e := range g.out[n] {
+				inDegree[g.edges[e].To.Instance]--
+			}
+			break
+		}
+	}
+	return order, nil
+}
+
+// DanglingPorts returns the declared ports of instances that are neither connected by the connection map nor bound to
+// a stream. Ports of connections that are not declared by the instance are returned as well. Only instances whose
+// function restrictions declare ports are checked.
+func (g *Graph) DanglingPorts() []InstancePort {
+	var dangling []InstancePort
+	for _, n := range g.nodes {
+		dangling = append(dangling, g.danglingPorts(n, g.inputPorts[n], g.in[n], false)...)
+		dangling = append(dangling, g.danglingPorts(n, g.outputPorts[n], g.out[n], true)...)
+	}
+	return dangling
+}
+
+func (g *Graph) danglingPorts(instance string, ports []Port, edges []int, output bool) []InstancePort {
+	if len(ports) == 0 {
+		return nil
+	}
+
+	connected := make(map[string]bool, len(edges))
+	var undeclared []InstancePort
+	for _, e := range edges {
+		name := g.edges[e].To.PortName
+		if output {
+			name = g.edges[e].From.PortName
+		}
+		connected[name] = true
+		if p := (InstancePort{instance, name, output}); !hasPort(ports, name) && !slices.Contains(undeclared, p) {
+			undeclared = append(undeclared, p)
+		}
+	}
+
+	var dangling []InstancePort
+	for _, p := range ports {
+		if !connected[p.PortName] && p.Bind == nil {
+			dangling = append(dangling, InstancePort{instance, p.PortName, output})
+		}
+	}
+	return append(dangling, undeclared...)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+func connection(id, from, fromPort, to, toPort string) nbmp.ConnectionMapping {
+	return nbmp.ConnectionMapping{
+		ConnectionID: id,
+		From:         nbmp.ConnectionMappingPort{ID: from, Instance: from, PortName: fromPort},
+		To:           nbmp.ConnectionMappingPort{ID: to, Instance: to, PortName: toPort},
+	}
+}
+
+// diamond: split -> (scale-a, scale-b) -> merge -> package
+func newDiamond() *nbmp.Processing {
+	return &nbmp.Processing{
+		ConnectionMap: []nbmp.ConnectionMapping{
+			connection("split-a", "split", "out-a", "scale-a", "in"),
+			connection("split-b", "split", "out-b", "scale-b", "in"),
+			connection("b-merge", "scale-b", "out", "merge", "in-b"),
+			connection("a-merge", "scale-a", "out", "merge", "in-a"),
+			connection("merge-package", "merge", "out", "package", "in"),
+		},
+		FunctionRestrictions: []nbmp.FunctionRestriction{
+			{Instance: "monitor"},
+			{
+				Instance: "merge",
+				General: &nbmp.General{
+					InputPorts:  []nbmp.Port{{PortName: "in-a"}, {PortName: "in-b"}, {PortName: "in-c"}},
+					OutputPorts: []nbmp.Port{{PortName: "out"}, {PortName: "debug", Bind: &nbmp.PortBinding{StreamID: "debug"}}},
+				},
+			},
+			{
+				Instance: "package",
+				General: &nbmp.General{
+					InputPorts: []nbmp.Port{{PortName: "input"}},
+				},
+			},
+		},
+	}
+}
+
+func TestGraph(t *testing.T) {
+	g := newDiamond().Graph()
+
+	for _, tc := range []struct {
+		name      string
+		got, want any
+	}{
+		{"nodes", g.Nodes(), []string{"split", "scale-a", "scale-b", "merge", "package", "monitor"}},
+		{"edges", g.Edges(), []string{"split-a", "split-b", "b-merge", "a-merge", "merge-package"}},
+		{"in edges", g.InEdges("merge"), []string{"b-merge", "a-merge"}},
+		{"out edges", g.OutEdges("split"), []string{"split-a", "split-b"}},
+		{"sources", g.Sources(), []string{"split", "monitor"}},
+		{"sinks", g.Sinks(), []string{"package", "monitor"}},
+		{"upstream", g.Upstream("merge"), []string{"split", "scale-a", "scale-b"}},
+		{"downstream", g.Downstream("scale-b"), []string{"merge", "package"}},
+		{"cycle", g.Cycle(), []string(nil)},
+		{"dangling ports", g.DanglingPorts(), []nbmp.InstancePort{
+			{Instance: "merge", PortName: "in-c"},
+			{Instance: "package", PortName: "input"},
+			{Instance: "package", PortName: "in"},
+		}},
+	} {
+		if diff := cmp.Diff(tc.want, tc.got); diff != "" {
+			t.Errorf("%s: unexpected result (-want +got):\n%s", tc.name, diff)
+		}
+	}
+
+	order, err := g.TopologicalOrder()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{"split", "scale-a", "scale-b", "merge", "package", "monitor"}
+	if diff := cmp.Diff(want, order); diff != "" {
+		t.Errorf("unexpected topological order (-want +got):\n%s", diff)
+	}
+
+	cm, ok := g.Edge("a-merge")
+	if !ok || cm.From.Instance != "scale-a" || cm.To.PortName != "in-a" {
+		t.Errorf("unexpected edge %v", cm)
+	}
+	if _, ok := g.Edge("missing"); ok {
+		t.Error("expected missing edge")
+	}
+}
+
+func TestGraphCycle(t *testing.T) {
+	p := newDiamond()
+	p.ConnectionMap = append(p.ConnectionMap, connection("package-scale-b", "package", "feedback", "scale-b", "in"))
+	g := p.Graph()
+
+	want := []string{"merge", "package", "scale-b"}
+	if diff := cmp.Diff(want, g.Cycle()); diff != "" {
+		t.Errorf("unexpected cycle (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"split", "scale-a", "scale-b", "merge", "package"}, g.Upstream("merge")); diff != "" {
+		t.Errorf("unexpected upstream (-want +got):\n%s", diff)
+	}
+
+	_, err := g.TopologicalOrder()
+	var cycleErr *nbmp.CycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected CycleError, got %v", err)
+	}
+	if msg := "connection map has cycle: merge -> package -> scale-b -> merge"; err.Error() != msg {
+		t.Errorf("unexpected error message %q", err)
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// GroupInstanceSeparator separates the instance of a function group from the instances of its sub-functions in the
+// instance names of flattened connection maps.
+const GroupInstanceSeparator = "."
+
+// GroupPortsInstance is the reserved instance that references the external ports of a function group in its own
+// connection map. It is an extension to NBMP that allows function groups to contain instances of themselves to be
+// detected as recursion regardless of their port names.
+const GroupPortsInstance = "$group"
+
+// RecursiveGroupError is returned if a function group contains itself.
+// +kubebuilder:object:generate=false
+type RecursiveGroupError struct {
+	// IDs of the function groups forming the recursion. The last group contains the first.
+	Functions []string
+}
+
+func (e *RecursiveGroupError) Error() string {
+	if len(e.Functions) == 0 {
+		return "function group is recursive"
+	}
+	return "function group is recursive: " + strings.Join(append(slices.Clone(e.Functions), e.Functions[0]), " -> ")
+}
+
+// Flatten returns a copy of wf in which all instances of function groups in the connection map are replaced by the
+// sub-functions of the group. Function groups are resolved from the function repositories as described for Expander
+// and flattened recursively.
+//
+// The connection map of a function group describes the connections between its sub-functions. Connections whose from
+// or to port references the ID of the group itself map the external ports of the group onto ports of its
+// sub-functions: a connection from an input port of the group to an input port of a sub-function maps the group input
+// port onto that port (an input port may be mapped onto several ports); a connection from an output port of a
+// sub-function to an output port of the group maps the group output port onto that port. The external ports are
+// referenced with the instance GroupPortsInstance or, as in NBMP, with any other instance that is only used for input
+// ports of the group in from objects and output ports of the group in to objects. Other instances of the group itself
+// are reported as recursion.
+//
+// Instances and connection IDs of sub-functions are prefixed with the group instance and GroupInstanceSeparator, e.g.
+// the instance "encode" of the group instance "abr" becomes "abr.encode". Connections of the enclosing connection map
+// to an input port mapped onto several ports are split into one connection per port with an index appended to the
+// connection ID; indices already used by other connection IDs are skipped. Function restrictions of the group are
+// carried over for the renamed instances.
+//
+// Flatten returns an error wrapping ErrFunctionNotFound if a function cannot be resolved and a RecursiveGroupError if
+// a function group contains itself.
+func (e *Expander) Flatten(ctx context.Context, wf *Workflow) (*Workflow, error) {
+	repos, err := e.repositories(ctx, wf.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("Expander.Flatten: %w", err)
+	}
+	p, err := flatten(ctx, repos, &wf.Processing, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Expander.Flatten: %w", err)
+	}
+
+	out := wf.DeepCopy()
+	out.Processing.ConnectionMap = p.ConnectionMap
+	out.Processing.FunctionRestrictions = p.FunctionRestrictions
+	return out, nil
+}
+
+// flatten returns a copy of the connection map and function restrictions of p with all function groups inlined.
+// self is the general descriptor of the function group p belongs to or nil for workflows. Ports referencing the
+// external ports of self (see groupPortInstances) are left untouched; other instances of self are resolved like any
+// other function and thus reported as recursion. groups are the IDs of the enclosing groups.
+func flatten(ctx context.Context, repos *repositoryChain, p *Processing, self *General, groups []string) (*Processing, error) {
+	out := &Processing{}
+	for i := range p.ConnectionMap {
+		out.ConnectionMap = append(out.ConnectionMap, *p.ConnectionMap[i].DeepCopy())
+	}
+	for i := range p.FunctionRestrictions {
+		out.FunctionRestrictions = append(out.FunctionRestrictions, *p.FunctionRestrictions[i].DeepCopy())
+	}
+
+	functionIDs, order, err := instanceFunctions(p, self)
+	if err != nil {
+		return nil, err
+	}
+	instances := make(map[string]bool, len(order))
+	for _, instance := range order {
+		instances[instance] = true
+	}
+
+	for _, instance := range order {
+		id := functionIDs[instance]
+		fn, err := repos.function(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("instance %q: %w", instance, err)
+		}
+		if fn.General.IsGroup == nil || !*fn.General.IsGroup {
+			continue
+		}
+		if i := slices.Index(groups, id); i >= 0 {
+			return nil, &RecursiveGroupError{Functions: slices.Clone(groups[i:])}
+		}
+		if fn.Processing == nil || len(fn.Processing.ConnectionMap) == 0 {
+			return nil, fmt.Errorf("instance %q: function group %q has no connection map", instance, id)
+		}
+		if slices.ContainsFunc(out.FunctionRestrictions, func(fr FunctionRestriction) bool { return fr.Instance == instance }) {
+			return nil, fmt.Errorf("instance %q: function restrictions of function groups are not supported", instance)
+		}
+
+		inner, err := flatten(ctx, repos, fn.Processing, &fn.General, append(groups, id))
+		if err != nil {
+			return nil, fmt.Errorf("instance %q: %w", instance, err)
+		}
+		if err := inlineGroup(out, inner, instance, id, instances); err != nil {
+			return nil, fmt.Errorf("instance %q: %w", instance, err)
+		}
+	}
+
+	ids := make(map[string]bool, len(out.ConnectionMap))
+	for _, cm := range out.ConnectionMap {
+		if ids[cm.ConnectionID] {
+			return nil, fmt.Errorf("duplicate connection ID %q", cm.ConnectionID)
+		}
+		ids[cm.ConnectionID] = true
+	}
+	return out, nil
+}
+
+// instanceFunctions returns the function IDs of the instances of the connection map of p in order of appearance.
+// Ports referencing the external ports of the function group self are ignored.
+func instanceFunctions(p *Processing, self *General) (map[string]string, []string, error) {
+	var groupPorts map[string]bool
+	if self != nil {
+		groupPorts = groupPortInstances(p, self)
+	}
+
+	functionIDs := make(map[string]string)
+	var order []string
+	for _, cm := range p.ConnectionMap {
+		for _, port := range []ConnectionMappingPort{cm.From, cm.To} {
+			if self != nil && port.Instance == GroupPortsInstance && port.ID != self.ID {
+				return nil, nil, fmt.Errorf("instance %q is reserved for the ports of function group %q", port.Instance, self.ID)
+			}
+			if self != nil && port.ID == self.ID && groupPorts[port.Instance] {
+				continue
+			}
+			id, ok := functionIDs[port.Instance]
+			if ok && id != port.ID {
+				return nil, nil, fmt.Errorf("instance %q references functions %q and %q", port.Instance, id, port.ID)
+			}
+			if !ok {
+				functionIDs[port.Instance] = port.ID
+				order = append(order, port.Instance)
+			}
+		}
+	}
+	return functionIDs, order, nil
+}
+
+// groupPortInstances returns the instances of the function group self in the connection map p of the group that
+// reference its external ports: GroupPortsInstance and instances only used for input ports of the group in from
+// objects and output ports of the group in to objects.
+func groupPortInstances(p *Processing, self *General) map[string]bool {
+	groupPorts := map[string]bool{GroupPortsInstance: true}
+	nested := make(map[string]bool)
+	check := func(port ConnectionMappingPort, ports []Port) {
+		if port.ID != self.ID || port.Instance == GroupPortsInstance {
+			return
+		}
+		if hasPort(ports, port.PortName) {
+			groupPorts[port.Instance] = true
+		} else {
+			nested[port.Instance] = true
+		}
+	}
+	for _, cm := range p.ConnectionMap {
+		check(cm.From, self.InputPorts)
+		check(cm.To, self.OutputPorts)
+	}
+	for instance := range nested {
+		delete(groupPorts, instance)
+	}
+	return groupPorts
+}
+
+// inlineGroup replaces the group instance of function group id in out by the flattened connection map inner of the
+// group. instances are the instance names in use and are updated with the inlined instances.
+func inlineGroup(out, inner *Processing, instance, id string, instances map[string]bool) error {
+	prefix := instance + GroupInstanceSeparator
+	inputs := make(map[string][]ConnectionMappingPort)
+	outputs := make(map[string]ConnectionMappingPort)
+	var connections []ConnectionMapping
+	newInstances := make(map[string]bool)
+	for _, cm := range inner.ConnectionMap {
+		// flatten reported all other instances of the group itself as recursion
+		fromGroup, toGroup := cm.From.ID == id, cm.To.ID == id
+		if !fromGroup {
+			cm.From.Instance = prefix + cm.From.Instance
+			newInstances[cm.From.Instance] = true
+		}
+		if !toGroup {
+			cm.To.Instance = prefix + cm.To.Instance
+			newInstances[cm.To.Instance] = true
+		}
+
+		switch {
+		case fromGroup && toGroup:
+			return fmt.Errorf("connection %q of function group %q connects two group ports", cm.ConnectionID, id)
+		case fromGroup:
+			inputs[cm.From.PortName] = append(inputs[cm.From.PortName], cm.To)
+		case toGroup:
+			if _, ok := outputs[cm.To.PortName]; ok {
+				return fmt.Errorf("output port %q of function group %q is mapped more than once", cm.To.PortName, id)
+			}
+			outputs[cm.To.PortName] = cm.From
+		default:
+			cm.ConnectionID = prefix + cm.ConnectionID
+			connections = append(connections, cm)
+		}
+	}
+	for name := range newInstances {
+		if instances[name] {
+			return fmt.Errorf("instance %q of function group %q is already in use", name, id)
+		}
+		instances[name] = true
+	}
+
+	used := make(map[string]bool, len(out.ConnectionMap)+len(connections))
+	for _, cm := range out.ConnectionMap {
+		used[cm.ConnectionID] = true
+	}
+	for _, cm := range connections {
+		used[cm.ConnectionID] = true
+	}
+
+	var rewritten []ConnectionMapping
+	for _, cm := range out.ConnectionMap {
+		if cm.From.Instance == instance && cm.From.ID == id {
+			inner, ok := outputs[cm.From.PortName]
+			if !ok {
+				return fmt.Errorf("output port %q of function group %q is not mapped", cm.From.PortName, id)
+			}
+			cm.From = withRestrictions(inner, cm.From)
+		}
+		if cm.To.Instance != instance || cm.To.ID != id {
+			rewritten = append(rewritten, cm)
+			continue
+		}
+
+		targets := inputs[cm.To.PortName]
+		if len(targets) == 0 {
+			return fmt.Errorf("input port %q of function group %q is not mapped", cm.To.PortName, id)
+		}
+		n := 0
+		for _, to := range targets {
+			c := *cm.DeepCopy()
+			c.To = withRestrictions(to, cm.To)
+			for len(targets) > 1 {
+				c.ConnectionID = cm.ConnectionID + GroupInstanceSeparator + strconv.Itoa(n)
+				n++
+				if !used[c.ConnectionID] {
+					used[c.ConnectionID] = true
+					break
+				}
+			}
+			rewritten = append(rewritten, c)
+		}
+	}
+	out.ConnectionMap = append(rewritten, connections...)
+
+	for _, fr := range inner.FunctionRestrictions {
+		fr.Instance = prefix + fr.Instance
+		out.FunctionRestrictions = append(out.FunctionRestrictions, fr)
+	}
+	return nil
+}
+
+// withRestrictions returns the inner port with the input and output restrictions of the outer port if given.
+func withRestrictions(inner, outer ConnectionMappingPort) ConnectionMappingPort {
+	p := *inner.DeepCopy()
+	if outer.InputRestrictions != nil {
+		p.InputRestrictions = outer.InputRestrictions.DeepCopy()
+	}
+	if outer.OutputRestrictions != nil {
+		p.OutputRestrictions = outer.OutputRestrictions.DeepCopy()
+	}
+	return p
+}
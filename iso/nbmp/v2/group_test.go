@@ -0,0 +1,282 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+const abrFunctionID = "urn:example:function:abr"
+
+// link is like connection, but from and to are given as "<function ID>/<instance>".
+func link(id, from, fromPort, to, toPort string) nbmp.ConnectionMapping {
+	port := func(s, name string) nbmp.ConnectionMappingPort {
+		i := strings.LastIndexByte(s, '/')
+		return nbmp.ConnectionMappingPort{ID: s[:i], Instance: s[i+1:], PortName: name}
+	}
+	return nbmp.ConnectionMapping{ConnectionID: id, From: port(from, fromPort), To: port(to, toPort)}
+}
+
+func newABRGroup() *nbmp.Function {
+	return &nbmp.Function{
+		General: nbmp.General{
+			ID:          abrFunctionID,
+			Name:        "abr",
+			Description: "Transcodes and packages a video stream as DASH",
+			InputPorts:  []nbmp.Port{{PortName: "in"}},
+			OutputPorts: []nbmp.Port{{PortName: "dash"}},
+			IsGroup:     ptr(true),
+		},
+		Processing: &nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				link("in-hd", abrFunctionID+"/"+nbmp.GroupPortsInstance, "in", transcodeFunctionID+"/transcode-hd", "in"),
+				link("in-sd", abrFunctionID+"/"+nbmp.GroupPortsInstance, "in", transcodeFunctionID+"/transcode-sd", "in"),
+				link("hd-package", transcodeFunctionID+"/transcode-hd", "out", packageFunctionID+"/package", "video-1"),
+				link("sd-package", transcodeFunctionID+"/transcode-sd", "out", packageFunctionID+"/package", "video-2"),
+				link("dash", packageFunctionID+"/package", "dash", abrFunctionID+"/"+nbmp.GroupPortsInstance, "dash"),
+			},
+			FunctionRestrictions: []nbmp.FunctionRestriction{{
+				Instance: "transcode-sd",
+				General:  &nbmp.General{Description: "Transcodes to SD"},
+			}},
+		},
+	}
+}
+
+func newGroupRepository(t *testing.T, groups ...*nbmp.Function) nbmp.MemoryFunctionRepository {
+	repo, err := nbmp.NewFSFunctionRepository(os.DirFS("testdata/repository"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, g := range groups {
+		repo[g.General.ID] = g
+	}
+	return repo
+}
+
+func newABRWorkflow() *nbmp.Workflow {
+	return &nbmp.Workflow{
+		General: nbmp.General{ID: "wf"},
+		Processing: nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				link("ingest-abr", transcodeFunctionID+"/ingest", "out", abrFunctionID+"/abr", "in"),
+				link("abr-publish", abrFunctionID+"/abr", "dash", transcodeFunctionID+"/publish", "in"),
+			},
+		},
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	e := &nbmp.Expander{Default: newGroupRepository(t, newABRGroup())}
+	wf := newABRWorkflow()
+	got, err := e.Flatten(context.Background(), wf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []nbmp.ConnectionMapping{
+		link("ingest-abr.0", transcodeFunctionID+"/ingest", "out", transcodeFunctionID+"/abr.transcode-hd", "in"),
+		link("ingest-abr.1", transcodeFunctionID+"/ingest", "out", transcodeFunctionID+"/abr.transcode-sd", "in"),
+		link("abr-publish", packageFunctionID+"/abr.package", "dash", transcodeFunctionID+"/publish", "in"),
+		link("abr.hd-package", transcodeFunctionID+"/abr.transcode-hd", "out", packageFunctionID+"/abr.package", "video-1"),
+		link("abr.sd-package", transcodeFunctionID+"/abr.transcode-sd", "out", packageFunctionID+"/abr.package", "video-2"),
+	}
+	if diff := cmp.Diff(want, got.Processing.ConnectionMap); diff != "" {
+		t.Errorf("unexpected connection map (-want +got):\n%s", diff)
+	}
+	if len(got.Processing.FunctionRestrictions) != 1 || got.Processing.FunctionRestrictions[0].Instance != "abr.transcode-sd" {
+		t.Errorf("unexpected function restrictions: %v", got.Processing.FunctionRestrictions)
+	}
+	if len(wf.Processing.ConnectionMap) != 2 {
+		t.Error("Flatten modified the workflow")
+	}
+
+	tasks, err := e.Expand(context.Background(), wf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.General.ID)
+	}
+	if diff := cmp.Diff([]string{"ingest", "abr.transcode-hd", "abr.transcode-sd", "abr.package", "publish"}, ids); diff != "" {
+		t.Errorf("unexpected tasks (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenNBMPGroupPorts(t *testing.T) {
+	// external ports referenced by an arbitrary instance of the group as in NBMP
+	group := newABRGroup()
+	for i, cm := range group.Processing.ConnectionMap {
+		if cm.From.Instance == nbmp.GroupPortsInstance {
+			group.Processing.ConnectionMap[i].From.Instance = "abr"
+		}
+		if cm.To.Instance == nbmp.GroupPortsInstance {
+			group.Processing.ConnectionMap[i].To.Instance = "abr"
+		}
+	}
+
+	want, err := (&nbmp.Expander{Default: newGroupRepository(t, newABRGroup())}).Flatten(context.Background(), newABRWorkflow())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got, err := (&nbmp.Expander{Default: newGroupRepository(t, group)}).Flatten(context.Background(), newABRWorkflow())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected workflow (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenUniqueConnectionIDs(t *testing.T) {
+	e := &nbmp.Expander{Default: newGroupRepository(t, newABRGroup())}
+	wf := newABRWorkflow()
+	wf.Processing.ConnectionMap[1].ConnectionID = "ingest-abr.0"
+
+	got, err := e.Flatten(context.Background(), wf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var ids []string
+	for _, cm := range got.Processing.ConnectionMap {
+		ids = append(ids, cm.ConnectionID)
+	}
+	want := []string{"ingest-abr.1", "ingest-abr.2", "ingest-abr.0", "abr.hd-package", "abr.sd-package"}
+	if diff := cmp.Diff(want, ids); diff != "" {
+		t.Errorf("unexpected connection IDs (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenNested(t *testing.T) {
+	outer := &nbmp.Function{
+		General: nbmp.General{ID: "urn:example:function:outer", IsGroup: ptr(true)},
+		Processing: &nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				link("in", "urn:example:function:outer/"+nbmp.GroupPortsInstance, "in", abrFunctionID+"/inner", "in"),
+				link("out", abrFunctionID+"/inner", "dash", "urn:example:function:outer/"+nbmp.GroupPortsInstance, "out"),
+			},
+		},
+	}
+	e := &nbmp.Expander{Default: newGroupRepository(t, outer, newABRGroup())}
+	wf := &nbmp.Workflow{Processing: nbmp.Processing{ConnectionMap: []nbmp.ConnectionMapping{
+		link("ingest", transcodeFunctionID+"/ingest", "out", "urn:example:function:outer/x", "in"),
+		link("publish", "urn:example:function:outer/x", "out", transcodeFunctionID+"/publish", "in"),
+	}}}
+
+	got, err := e.Flatten(context.Background(), wf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var ids []string
+	for _, cm := range got.Processing.ConnectionMap {
+		ids = append(ids, cm.ConnectionID+": "+cm.From.Instance+" -> "+cm.To.Instance)
+	}
+	want := []string{
+		"ingest.0: ingest -> x.inner.transcode-hd",
+		"ingest.1: ingest -> x.inner.transcode-sd",
+		"publish: x.inner.package -> publish",
+		"x.inner.hd-package: x.inner.transcode-hd -> x.inner.package",
+		"x.inner.sd-package: x.inner.transcode-sd -> x.inner.package",
+	}
+	if diff := cmp.Diff(want, ids); diff != "" {
+		t.Errorf("unexpected connection map (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenErrors(t *testing.T) {
+	// abr -> wrapper -> abr
+	recursive := newABRGroup()
+	recursive.Processing.ConnectionMap[2].To = nbmp.ConnectionMappingPort{ID: "urn:example:function:wrapper", Instance: "wrapper", PortName: "in"}
+	wrapper := &nbmp.Function{
+		General: nbmp.General{ID: "urn:example:function:wrapper", IsGroup: ptr(true)},
+		Processing: &nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				link("in", "urn:example:function:wrapper/"+nbmp.GroupPortsInstance, "in", abrFunctionID+"/abr", "in"),
+			},
+		},
+	}
+
+	// abr -> abr
+	direct := newABRGroup()
+	direct.Processing.ConnectionMap[2].To = nbmp.ConnectionMappingPort{ID: abrFunctionID, Instance: "nested", PortName: "in"}
+
+	// abr -> abr with the instance also used for the external ports
+	directShared := newABRGroup()
+	directShared.Processing.ConnectionMap[0].From.Instance = "abr"
+	directShared.Processing.ConnectionMap[2].To = nbmp.ConnectionMappingPort{ID: abrFunctionID, Instance: "abr", PortName: "in"}
+
+	reserved := newABRGroup()
+	reserved.Processing.ConnectionMap[2].To.Instance = nbmp.GroupPortsInstance
+
+	unmapped := newABRGroup()
+	unmapped.Processing.ConnectionMap = unmapped.Processing.ConnectionMap[:4]
+
+	collision := newABRWorkflow()
+	collision.Processing.ConnectionMap = append(collision.Processing.ConnectionMap,
+		link("collision", transcodeFunctionID+"/publish", "out", transcodeFunctionID+"/abr.transcode-hd", "in"))
+
+	tests := []struct {
+		name  string
+		group *nbmp.Function
+		wf    *nbmp.Workflow
+		check func(error) bool
+	}{
+		{"recursive group", recursive, newABRWorkflow(), func(err error) bool {
+			var groupErr *nbmp.RecursiveGroupError
+			return errors.As(err, &groupErr) &&
+				cmp.Equal([]string{abrFunctionID, "urn:example:function:wrapper"}, groupErr.Functions)
+		}},
+		{"directly recursive group", direct, newABRWorkflow(), func(err error) bool {
+			var groupErr *nbmp.RecursiveGroupError
+			return errors.As(err, &groupErr) && cmp.Equal([]string{abrFunctionID}, groupErr.Functions)
+		}},
+		{"directly recursive group sharing an instance with external ports", directShared, newABRWorkflow(),
+			func(err error) bool {
+				var groupErr *nbmp.RecursiveGroupError
+				return errors.As(err, &groupErr) && cmp.Equal([]string{abrFunctionID}, groupErr.Functions)
+			}},
+		{"reserved instance", reserved, newABRWorkflow(), func(err error) bool {
+			var groupErr *nbmp.RecursiveGroupError
+			return err != nil && !errors.As(err, &groupErr)
+		}},
+		{"unresolved group", nil, newABRWorkflow(), func(err error) bool {
+			return errors.Is(err, nbmp.ErrFunctionNotFound)
+		}},
+		{"unmapped output port", unmapped, newABRWorkflow(), func(err error) bool { return err != nil }},
+		{"instance collision", newABRGroup(), collision, func(err error) bool { return err != nil }},
+	}
+
+	for _, tc := range tests {
+		repo := newGroupRepository(t, wrapper)
+		if tc.group != nil {
+			repo[abrFunctionID] = tc.group
+		}
+		e := &nbmp.Expander{Default: repo}
+		if _, err := e.Flatten(context.Background(), tc.wf); !tc.check(err) {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+	}
+}
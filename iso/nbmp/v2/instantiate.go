@@ -0,0 +1,232 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TaskOption configures NewTaskFromFunction.
+// +kubebuilder:object:generate=false
+type TaskOption func(*taskOptions)
+
+type taskOptions struct {
+	taskID      string
+	instance    string
+	restriction *FunctionRestriction
+	streamID    func(streamID string) string
+}
+
+// WithTaskID sets the ID of the task. It defaults to the instance or, if no instance is given, the ID of the function.
+func WithTaskID(id string) TaskOption {
+	return func(o *taskOptions) {
+		o.taskID = id
+	}
+}
+
+// WithInstance sets the function instance the task is created for.
+func WithInstance(instance string) TaskOption {
+	return func(o *taskOptions) {
+		o.instance = instance
+	}
+}
+
+// WithFunctionRestriction applies the descriptors of fr to the task. The instance of fr is used if no instance is set.
+func WithFunctionRestriction(fr *FunctionRestriction) TaskOption {
+	return func(o *taskOptions) {
+		o.restriction = fr
+	}
+}
+
+// WithStreamIDFunc sets the function that assigns the stream ID of a task input or output given the stream ID of the
+// function input or output (or its name if the function does not define a stream ID). By default, stream IDs are
+// prefixed with the task ID, e.g. "task.stream".
+func WithStreamIDFunc(f func(streamID string) string) TaskOption {
+	return func(o *taskOptions) {
+		o.streamID = f
+	}
+}
+
+// NewTaskFromFunction returns a task description document for the function description document fn. The descriptors
+// of fn are deep copied. Stream IDs of inputs and outputs are assigned and port bindings updated accordingly (see
+// WithStreamIDFunc). Descriptors of a function restriction override those of fn; general descriptors are merged field
+// by field (see WithFunctionRestriction). Descriptors listed in the blacklist of the restriction are removed. Fields
+// that must not be present in task description documents are cleared. The task is validated with
+// Task.Validate(TaskDescriptionDocumentKind).
+func NewTaskFromFunction(fn *Function, opts ...TaskOption) (*Task, error) {
+	o := &taskOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.instance == "" && o.restriction != nil {
+		o.instance = o.restriction.Instance
+	}
+	if o.taskID == "" {
+		o.taskID = o.instance
+	}
+	if o.taskID == "" {
+		o.taskID = fn.General.ID
+	}
+	if o.streamID == nil {
+		o.streamID = func(streamID string) string {
+			return o.taskID + "." + streamID
+		}
+	}
+
+	fn = fn.DeepCopy()
+	t := &Task{
+		Scheme:          fn.Scheme,
+		General:         fn.General,
+		Input:           fn.Input,
+		Output:          fn.Output,
+		Configuration:   fn.Configuration,
+		Step:            fn.Step,
+		ClientAssistant: fn.ClientAssistant,
+		Assertion:       fn.Assertion,
+		Security:        fn.Security,
+	}
+	if fn.Processing != nil {
+		t.Processing = *fn.Processing
+	}
+	if fn.Requirement != nil {
+		t.Requirement = *fn.Requirement
+	}
+
+	if fr := o.restriction; fr != nil {
+		fr = fr.DeepCopy()
+		if fr.General != nil {
+			mergeNonZero(&t.General, fr.General)
+		}
+		if fr.Processing != nil {
+			t.Processing = *fr.Processing
+		}
+		if fr.Requirements != nil {
+			t.Requirement = *fr.Requirements
+		}
+		if fr.Configuration != nil {
+			t.Configuration = fr.Configuration
+		}
+		if fr.ClientAssistant != nil {
+			t.ClientAssistant = fr.ClientAssistant
+		}
+		if fr.Failover != nil {
+			t.Failover = fr.Failover
+		}
+		if fr.Monitoring != nil {
+			t.Monitoring = fr.Monitoring
+		}
+		if fr.Reporting != nil {
+			t.Reporting = fr.Reporting
+		}
+		if fr.Notification != nil {
+			t.Notification = fr.Notification
+		}
+		if fr.Step != nil {
+			t.Step = fr.Step
+		}
+		if fr.Security != nil {
+			t.Security = fr.Security
+		}
+		for _, b := range fr.Blacklist {
+			t.applyBlacklist(b)
+		}
+	}
+
+	t.General.ID = o.taskID
+	if t.General.State == nil {
+		t.General.State = ptr(InstantiatedState)
+	}
+	t.assignStreamIDs(o.streamID)
+
+	// not allowed in task description documents
+	t.Processing.ConnectionMap = nil
+	t.Processing.FunctionRestrictions = nil
+
+	if err := t.Validate(TaskDescriptionDocumentKind); err != nil {
+		return nil, fmt.Errorf("NewTaskFromFunction: %w", err)
+	}
+	return t, nil
+}
+
+func (t *Task) applyBlacklist(b Blacklist) {
+	switch b {
+	case RequirementBlacklist:
+		t.Requirement = Requirement{}
+	case ClientAssistantBlacklist:
+		t.ClientAssistant = nil
+	case FailOverBlacklist:
+		t.Failover = nil
+	case MonitoringBlacklist:
+		t.Monitoring = nil
+	case ReportingBlacklist:
+		t.Reporting = nil
+	case NotificationBlacklist:
+		t.Notification = nil
+	case SecurityBlacklist:
+		t.Security = nil
+	}
+}
+
+// assignStreamIDs assigns the stream IDs of inputs and outputs and updates the port bindings.
+func (t *Task) assignStreamIDs(assign func(string) string) {
+	ids := make(map[string]string)
+	for _, io := range []InputOrOutput{&t.Input, &t.Output} {
+		mps := io.GetMediaParameters()
+		for i := range mps {
+			assignStreamID(&mps[i], ids, assign)
+		}
+		mdps := io.GetMetadataParameters()
+		for i := range mdps {
+			assignStreamID(&mdps[i], ids, assign)
+		}
+	}
+
+	for _, ports := range [][]Port{t.General.InputPorts, t.General.OutputPorts} {
+		for i := range ports {
+			if b := ports[i].Bind; b != nil {
+				if id, ok := ids[b.StreamID]; ok {
+					b.StreamID = id
+				}
+			}
+		}
+	}
+}
+
+func assignStreamID(p MediaOrMetadataParameter, ids map[string]string, assign func(string) string) {
+	key := p.GetStreamID()
+	if key == "" {
+		key = p.GetName()
+	}
+	id := assign(key)
+	ids[key] = id
+	p.SetStreamID(id)
+}
+
+// mergeNonZero sets the fields of dst to the fields of src that are not zero.
+func mergeNonZero[T any](dst, src *T) {
+	d, s := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
+	for i := 0; i < s.NumField(); i++ {
+		if f := s.Field(i); !f.IsZero() {
+			d.Field(i).Set(f)
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
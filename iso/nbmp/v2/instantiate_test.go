@@ -0,0 +1,139 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nagare-media/models.go/base"
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+func newTranscodeFunction() *nbmp.Function {
+	return &nbmp.Function{
+		General: nbmp.General{
+			ID:          "urn:example:function:transcode",
+			Name:        "transcode",
+			Description: "Transcodes a video stream",
+			Rank:        ptr[uint64](1),
+			InputPorts:  []nbmp.Port{{PortName: "in", Bind: &nbmp.PortBinding{StreamID: "video-in"}}},
+			OutputPorts: []nbmp.Port{{PortName: "out", Bind: &nbmp.PortBinding{StreamID: "video-out"}}},
+		},
+		Input: nbmp.Input{
+			MediaParameters: []nbmp.MediaParameter{{StreamID: "video-in", Name: "video-in", MimeType: "video/mp4"}},
+		},
+		Output: nbmp.Output{
+			MediaParameters: []nbmp.MediaParameter{{Name: "video-out", MimeType: "video/mp4"}},
+		},
+		Processing: &nbmp.Processing{
+			Keywords: []string{"transcode"},
+			Image:    []nbmp.ProcessingImage{{URL: base.URI("docker://example/transcode")}},
+		},
+		Requirement: &nbmp.Requirement{
+			Hardware: &nbmp.HardwareRequirement{VCPU: ptr[uint64](2)},
+		},
+		Configuration: &nbmp.Configuration{Parameters: []nbmp.Parameter{{Name: "bitrate", ID: 1, Datatype: nbmp.IntegerDatatype}}},
+		Security:      &nbmp.Security{},
+	}
+}
+
+func TestNewTaskFromFunction(t *testing.T) {
+	fn := newTranscodeFunction()
+	orig := fn.DeepCopy()
+
+	task, err := nbmp.NewTaskFromFunction(fn)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := &nbmp.Task{
+		General: nbmp.General{
+			ID:          "urn:example:function:transcode",
+			Name:        "transcode",
+			Description: "Transcodes a video stream",
+			Rank:        ptr[uint64](1),
+			State:       ptr(nbmp.InstantiatedState),
+			InputPorts: []nbmp.Port{{PortName: "in",
+				Bind: &nbmp.PortBinding{StreamID: "urn:example:function:transcode.video-in"}}},
+			OutputPorts: []nbmp.Port{{PortName: "out",
+				Bind: &nbmp.PortBinding{StreamID: "urn:example:function:transcode.video-out"}}},
+		},
+		Input: nbmp.Input{MediaParameters: []nbmp.MediaParameter{
+			{StreamID: "urn:example:function:transcode.video-in", Name: "video-in", MimeType: "video/mp4"},
+		}},
+		Output: nbmp.Output{MediaParameters: []nbmp.MediaParameter{
+			{StreamID: "urn:example:function:transcode.video-out", Name: "video-out", MimeType: "video/mp4"},
+		}},
+		Processing:    *fn.Processing,
+		Requirement:   *fn.Requirement,
+		Configuration: fn.Configuration,
+		Security:      &nbmp.Security{},
+	}
+	if diff := cmp.Diff(want, task); diff != "" {
+		t.Errorf("unexpected task (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(orig, fn); diff != "" {
+		t.Errorf("function was modified (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewTaskFromFunctionRestriction(t *testing.T) {
+	fn := newTranscodeFunction()
+	fn.Processing.ConnectionMap = []nbmp.ConnectionMapping{connection("a-b", "a", "out", "b", "in")}
+
+	fr := &nbmp.FunctionRestriction{
+		Instance: "transcode-1",
+		General: &nbmp.General{
+			Description: "Transcodes to 1080p",
+			Priority:    ptr[uint64](3),
+		},
+		Requirements: &nbmp.Requirement{
+			Hardware: &nbmp.HardwareRequirement{VGPU: ptr[uint64](1)},
+		},
+		Monitoring: &nbmp.Monitoring{},
+		Blacklist:  []nbmp.Blacklist{nbmp.SecurityBlacklist, nbmp.MonitoringBlacklist},
+	}
+
+	task, err := nbmp.NewTaskFromFunction(fn, nbmp.WithFunctionRestriction(fr),
+		nbmp.WithStreamIDFunc(func(id string) string { return "s-" + id }))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if task.General.ID != "transcode-1" || task.General.Name != "transcode" ||
+		task.General.Description != "Transcodes to 1080p" || *task.General.Priority != 3 {
+		t.Errorf("unexpected general descriptor %+v", task.General)
+	}
+	if diff := cmp.Diff(fr.Requirements, &task.Requirement); diff != "" {
+		t.Errorf("unexpected requirement (-want +got):\n%s", diff)
+	}
+	if task.Security != nil || task.Monitoring != nil {
+		t.Error("expected blacklisted descriptors to be removed")
+	}
+	if task.Processing.ConnectionMap != nil {
+		t.Error("expected connection map to be removed")
+	}
+	if id := task.Output.MediaParameters[0].StreamID; id != "s-video-out" {
+		t.Errorf("unexpected stream ID %q", id)
+	}
+
+	fn.Output.MediaParameters[0].CompletionTimeout = ptr[uint64](1)
+	if _, err := nbmp.NewTaskFromFunction(fn, nbmp.WithTaskID("task")); err == nil {
+		t.Error("expected validation error")
+	}
+}
@@ -0,0 +1,737 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonschema implements a self-contained JSON Schema draft-07 validator for a fixed set of schema documents.
+//
+// All validation keywords of draft-07 are supported. Of the formats, only "uri" and "date-time" are asserted; other
+// formats are ignored. References are resolved by the base name of the referenced document, i.e. all documents have to
+// be part of the same file system. Unknown keywords are ignored.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"math"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Error is a violation of a schema.
+type Error struct {
+	// JSON pointer to the violating value in the instance, e.g. "/general/id". Empty for the root.
+	InstancePath string
+
+	// Location of the violated keyword, i.e. the document name and JSON pointer, e.g.
+	// "nbmp-schema-definitions.json#/general/required".
+	SchemaPath string
+
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", instancePath(e.InstancePath), e.Message)
+}
+
+func instancePath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+// Validator validates JSON instances against the schema documents of a file system. It is safe for concurrent use.
+type Validator struct {
+	docs      map[string]any
+	redirects map[string]string
+	regexps   map[string]*regexp.Regexp
+}
+
+// New returns a validator for all JSON documents in the root directory of fsys. References that are keys of redirects
+// are replaced by the corresponding value before they are resolved, which allows to fix erroneous references of
+// documents that cannot be changed. It returns an error if a document is not valid JSON, a reference cannot be
+// resolved or a pattern cannot be compiled.
+func New(fsys fs.FS, redirects map[string]string) (*Validator, error) {
+	v := &Validator{
+		docs:      make(map[string]any),
+		redirects: redirects,
+		regexps:   make(map[string]*regexp.Regexp),
+	}
+
+	names, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := decode(b)
+		if err != nil {
+			return nil, fmt.Errorf("jsonschema.New: %s: %w", name, err)
+		}
+		v.docs[name] = doc
+	}
+
+	for _, name := range names {
+		if err := v.prepare(name, v.docs[name]); err != nil {
+			return nil, fmt.Errorf("jsonschema.New: %s: %w", name, err)
+		}
+	}
+	return v, nil
+}
+
+// Compile returns a validator for the single schema document schema. The document has the empty name, i.e. instances
+// are validated with Validate("", raw).
+func Compile(schema []byte) (*Validator, error) {
+	doc, err := decode(schema)
+	if err != nil {
+		return nil, fmt.Errorf("jsonschema.Compile: %w", err)
+	}
+	v := &Validator{
+		docs:    map[string]any{"": doc},
+		regexps: make(map[string]*regexp.Regexp),
+	}
+	if err := v.prepare("", doc); err != nil {
+		return nil, fmt.Errorf("jsonschema.Compile: %w", err)
+	}
+	return v, nil
+}
+
+// prepare checks the references and compiles the patterns of the schema s and all schemas it references. Only
+// keywords holding schemas are descended into, so that e.g. a property named "pattern" is not taken for a keyword.
+func (v *Validator) prepare(doc string, s any) error {
+	return v.prepareSchema(doc, s, make(map[location]bool))
+}
+
+// prepareSchema prepares s; seen are the locations of the referenced schemas already prepared.
+func (v *Validator) prepareSchema(doc string, s any, seen map[location]bool) error {
+	m, ok := s.(map[string]any)
+	if !ok {
+		// boolean schema
+		return nil
+	}
+	for k, sub := range m {
+		var err error
+		switch k {
+		case "$ref":
+			ref, ok := sub.(string)
+			if !ok {
+				return fmt.Errorf("$ref is not a string")
+			}
+			rdoc, rs, rerr := v.resolve(doc, ref)
+			if rerr != nil {
+				return rerr
+			}
+			loc := location{doc: rdoc, pointer: v.pointer(ref)}
+			if !seen[loc] {
+				seen[loc] = true
+				err = v.prepareSchema(rdoc, rs, seen)
+			}
+		case "pattern":
+			err = v.compile(sub)
+		case "additionalItems", "additionalProperties", "contains", "propertyNames", "not", "if", "then", "else":
+			err = v.prepareSchema(doc, sub, seen)
+		case "items", "allOf", "anyOf", "oneOf":
+			if subs, ok := sub.([]any); ok {
+				for _, ss := range subs {
+					if err = v.prepareSchema(doc, ss, seen); err != nil {
+						break
+					}
+				}
+			} else {
+				err = v.prepareSchema(doc, sub, seen)
+			}
+		case "properties", "patternProperties", "definitions", "dependencies":
+			// maps of names to schemas; dependencies may also map to arrays of property names
+			subs, _ := sub.(map[string]any)
+			for name, ss := range subs {
+				if k == "patternProperties" {
+					if err = v.compile(name); err != nil {
+						break
+					}
+				}
+				if err = v.prepareSchema(doc, ss, seen); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *Validator) compile(p any) error {
+	s, ok := p.(string)
+	if !ok {
+		return fmt.Errorf("pattern is not a string")
+	}
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return err
+	}
+	v.regexps[s] = re
+	return nil
+}
+
+// resolve returns the schema ref points to relative to doc and the name of the document containing it.
+func (v *Validator) resolve(doc, ref string) (string, any, error) {
+	if r, ok := v.redirects[ref]; ok {
+		ref = r
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid $ref %q: %w", ref, err)
+	}
+	if u.Path != "" {
+		doc = path.Base(u.Path)
+	}
+	s, ok := v.docs[doc]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown document of $ref %q", ref)
+	}
+
+	if u.Fragment == "" || u.Fragment == "/" {
+		return doc, s, nil
+	}
+	if !strings.HasPrefix(u.Fragment, "/") {
+		return "", nil, fmt.Errorf("unsupported $ref %q", ref)
+	}
+	for _, tok := range strings.Split(u.Fragment[1:], "/") {
+		tok = strings.NewReplacer("~1", "/", "~0", "~").Replace(tok)
+		switch c := s.(type) {
+		case map[string]any:
+			s, ok = c[tok]
+		case []any:
+			var i int
+			i, err = strconv.Atoi(tok)
+			ok = err == nil && i >= 0 && i < len(c)
+			if ok {
+				s = c[i]
+			}
+		default:
+			ok = false
+		}
+		if !ok {
+			return "", nil, fmt.Errorf("unresolvable $ref %q", ref)
+		}
+	}
+	return doc, s, nil
+}
+
+// pointer returns the JSON pointer of the resolved ref.
+func (v *Validator) pointer(ref string) string {
+	if r, ok := v.redirects[ref]; ok {
+		ref = r
+	}
+	_, frag, _ := strings.Cut(ref, "#")
+	return strings.TrimSuffix(frag, "/")
+}
+
+// Validate validates the JSON instance raw against the schema document named doc. It returns an error if raw is not
+// valid JSON or the schema references itself without descending into the instance, e.g. {"allOf": [{"$ref": "#"}]},
+// and the list of violations otherwise.
+func (v *Validator) Validate(doc string, raw []byte) ([]*Error, error) {
+	s, ok := v.docs[doc]
+	if !ok {
+		return nil, fmt.Errorf("Validator.Validate: unknown schema %q", doc)
+	}
+	inst, err := decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("Validator.Validate: %w", err)
+	}
+
+	st := &state{v: v, run: &run{active: make(map[refVisit]bool)}}
+	st.validate(location{doc: doc}, s, "", inst)
+	if st.run.err != nil {
+		return nil, fmt.Errorf("Validator.Validate: %w", st.run.err)
+	}
+	return st.errs, nil
+}
+
+func decode(raw []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	if d.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+// location of a schema
+type location struct {
+	doc     string
+	pointer string
+}
+
+func (l location) with(tokens ...string) location {
+	for _, t := range tokens {
+		l.pointer += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(t)
+	}
+	return l
+}
+
+func (l location) String() string {
+	return l.doc + "#" + l.pointer
+}
+
+type state struct {
+	v    *Validator
+	errs []*Error
+	run  *run
+}
+
+// run is the state shared by all states of one validation.
+type run struct {
+	// references currently being validated
+	active map[refVisit]bool
+
+	// error that aborts the validation, e.g. because the schema recurses infinitely
+	err error
+}
+
+// refVisit is the validation of the instance at instPath against the referenced schema at loc.
+type refVisit struct {
+	loc      location
+	instPath string
+}
+
+func (st *state) fail(err error) {
+	if st.run.err == nil {
+		st.run.err = err
+	}
+}
+
+// match reports whether str matches the pattern p compiled by New or Compile.
+func (st *state) match(p, str string) bool {
+	re, ok := st.v.regexps[p]
+	if !ok {
+		st.fail(fmt.Errorf("pattern %q was not compiled", p))
+		return false
+	}
+	return re.MatchString(str)
+}
+
+func (st *state) errorf(loc location, keyword, instPath, format string, args ...any) {
+	st.errs = append(st.errs, &Error{
+		InstancePath: instPath,
+		SchemaPath:   loc.with(keyword).String(),
+		Message:      fmt.Sprintf(format, args...),
+	})
+}
+
+// valid reports whether inst is valid against s without recording errors.
+func (st *state) valid(loc location, s any, instPath string, inst any) bool {
+	sub := &state{v: st.v, run: st.run}
+	sub.validate(loc, s, instPath, inst)
+	return len(sub.errs) == 0
+}
+
+func (st *state) validate(loc location, s any, instPath string, inst any) {
+	if st.run.err != nil {
+		return
+	}
+	switch s := s.(type) {
+	case bool:
+		if !s {
+			st.errs = append(st.errs, &Error{InstancePath: instPath, SchemaPath: loc.String(), Message: "not allowed"})
+		}
+		return
+	case map[string]any:
+		st.validateObject(loc, s, instPath, inst)
+	}
+}
+
+func (st *state) validateObject(loc location, s map[string]any, instPath string, inst any) {
+	// $ref overrides all sibling keywords in draft-07
+	if ref, ok := s["$ref"].(string); ok {
+		doc, rs, err := st.v.resolve(loc.doc, ref)
+		if err != nil {
+			// checked by New and Compile
+			st.fail(err)
+			return
+		}
+		// a reference to a schema already being validated against the same instance never terminates
+		visit := refVisit{loc: location{doc: doc, pointer: st.v.pointer(ref)}, instPath: instPath}
+		if st.run.active[visit] {
+			st.fail(fmt.Errorf("$ref %q at %s recurses infinitely", ref, loc))
+			return
+		}
+		st.run.active[visit] = true
+		st.validate(visit.loc, rs, instPath, inst)
+		delete(st.run.active, visit)
+		return
+	}
+
+	st.validateGeneric(loc, s, instPath, inst)
+	st.validateCombinators(loc, s, instPath, inst)
+
+	switch inst := inst.(type) {
+	case json.Number:
+		st.validateNumber(loc, s, instPath, inst)
+	case string:
+		st.validateString(loc, s, instPath, inst)
+	case []any:
+		st.validateArray(loc, s, instPath, inst)
+	case map[string]any:
+		st.validateProperties(loc, s, instPath, inst)
+	}
+}
+
+func (st *state) validateGeneric(loc location, s map[string]any, instPath string, inst any) {
+	if t, ok := s["type"]; ok {
+		var types []string
+		switch t := t.(type) {
+		case string:
+			types = []string{t}
+		case []any:
+			for _, tt := range t {
+				if ts, ok := tt.(string); ok {
+					types = append(types, ts)
+				}
+			}
+		}
+		if !slices.ContainsFunc(types, func(t string) bool { return hasType(inst, t) }) {
+			st.errorf(loc, "type", instPath, "expected %s, got %s", strings.Join(types, " or "), typeOf(inst))
+		}
+	}
+
+	if enum, ok := s["enum"].([]any); ok {
+		if !slices.ContainsFunc(enum, func(e any) bool { return equal(e, inst) }) {
+			st.errorf(loc, "enum", instPath, "value is not one of the allowed values")
+		}
+	}
+
+	if c, ok := s["const"]; ok && !equal(c, inst) {
+		st.errorf(loc, "const", instPath, "value does not equal the constant")
+	}
+}
+
+func (st *state) validateCombinators(loc location, s map[string]any, instPath string, inst any) {
+	if allOf, ok := s["allOf"].([]any); ok {
+		for i, sub := range allOf {
+			st.validate(loc.with("allOf", strconv.Itoa(i)), sub, instPath, inst)
+		}
+	}
+
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		matched := false
+		for i, sub := range anyOf {
+			if st.valid(loc.with("anyOf", strconv.Itoa(i)), sub, instPath, inst) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			st.errorf(loc, "anyOf", instPath, "value does not match any schema of anyOf")
+		}
+	}
+
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		n := 0
+		for i, sub := range oneOf {
+			if st.valid(loc.with("oneOf", strconv.Itoa(i)), sub, instPath, inst) {
+				n++
+			}
+		}
+		if n != 1 {
+			st.errorf(loc, "oneOf", instPath, "value matches %d instead of exactly one schema of oneOf", n)
+		}
+	}
+
+	if not, ok := s["not"]; ok && st.valid(loc.with("not"), not, instPath, inst) {
+		st.errorf(loc, "not", instPath, "value matches the schema of not")
+	}
+
+	if cond, ok := s["if"]; ok {
+		if st.valid(loc.with("if"), cond, instPath, inst) {
+			if then, ok := s["then"]; ok {
+				st.validate(loc.with("then"), then, instPath, inst)
+			}
+		} else if els, ok := s["else"]; ok {
+			st.validate(loc.with("else"), els, instPath, inst)
+		}
+	}
+}
+
+func (st *state) validateNumber(loc location, s map[string]any, instPath string, n json.Number) {
+	f, _ := n.Float64()
+	limit := func(keyword string) (float64, bool) {
+		l, ok := s[keyword].(json.Number)
+		if !ok {
+			return 0, false
+		}
+		lf, err := l.Float64()
+		return lf, err == nil
+	}
+
+	if m, ok := limit("multipleOf"); ok && m > 0 {
+		if q := f / m; math.Abs(q-math.Round(q)) > 1e-9 {
+			st.errorf(loc, "multipleOf", instPath, "%s is not a multiple of %v", n, m)
+		}
+	}
+	if m, ok := limit("minimum"); ok && f < m {
+		st.errorf(loc, "minimum", instPath, "%s is less than %v", n, m)
+	}
+	if m, ok := limit("maximum"); ok && f > m {
+		st.errorf(loc, "maximum", instPath, "%s is greater than %v", n, m)
+	}
+	if m, ok := limit("exclusiveMinimum"); ok && f <= m {
+		st.errorf(loc, "exclusiveMinimum", instPath, "%s is not greater than %v", n, m)
+	}
+	if m, ok := limit("exclusiveMaximum"); ok && f >= m {
+		st.errorf(loc, "exclusiveMaximum", instPath, "%s is not less than %v", n, m)
+	}
+}
+
+func (st *state) validateString(loc location, s map[string]any, instPath string, str string) {
+	n := utf8.RuneCountInString(str)
+	if m, ok := count(s["minLength"]); ok && n < m {
+		st.errorf(loc, "minLength", instPath, "string is shorter than %d characters", m)
+	}
+	if m, ok := count(s["maxLength"]); ok && n > m {
+		st.errorf(loc, "maxLength", instPath, "string is longer than %d characters", m)
+	}
+
+	if p, ok := s["pattern"].(string); ok && !st.match(p, str) {
+		st.errorf(loc, "pattern", instPath, "%q does not match pattern %q", str, p)
+	}
+
+	switch s["format"] {
+	case "uri":
+		if u, err := url.Parse(str); err != nil || !u.IsAbs() {
+			st.errorf(loc, "format", instPath, "%q is not an absolute URI", str)
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+			st.errorf(loc, "format", instPath, "%q is not an RFC 3339 date-time", str)
+		}
+	}
+}
+
+func (st *state) validateArray(loc location, s map[string]any, instPath string, arr []any) {
+	if m, ok := count(s["minItems"]); ok && len(arr) < m {
+		st.errorf(loc, "minItems", instPath, "array has fewer than %d items", m)
+	}
+	if m, ok := count(s["maxItems"]); ok && len(arr) > m {
+		st.errorf(loc, "maxItems", instPath, "array has more than %d items", m)
+	}
+
+	if u, ok := s["uniqueItems"].(bool); ok && u {
+	unique:
+		for i := range arr {
+			for j := i + 1; j < len(arr); j++ {
+				if equal(arr[i], arr[j]) {
+					st.errorf(loc, "uniqueItems", instPath, "items %d and %d are equal", i, j)
+					break unique
+				}
+			}
+		}
+	}
+
+	switch items := s["items"].(type) {
+	case []any:
+		for i, item := range arr {
+			if i < len(items) {
+				st.validate(loc.with("items", strconv.Itoa(i)), items[i], itemPath(instPath, i), item)
+			} else if additional, ok := s["additionalItems"]; ok {
+				st.validate(loc.with("additionalItems"), additional, itemPath(instPath, i), item)
+			}
+		}
+	case nil:
+	default:
+		for i, item := range arr {
+			st.validate(loc.with("items"), items, itemPath(instPath, i), item)
+		}
+	}
+
+	if contains, ok := s["contains"]; ok {
+		matched := false
+		for i, item := range arr {
+			if st.valid(loc.with("contains"), contains, itemPath(instPath, i), item) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			st.errorf(loc, "contains", instPath, "no item matches the schema of contains")
+		}
+	}
+}
+
+func (st *state) validateProperties(loc location, s map[string]any, instPath string, obj map[string]any) {
+	if m, ok := count(s["minProperties"]); ok && len(obj) < m {
+		st.errorf(loc, "minProperties", instPath, "object has fewer than %d properties", m)
+	}
+	if m, ok := count(s["maxProperties"]); ok && len(obj) > m {
+		st.errorf(loc, "maxProperties", instPath, "object has more than %d properties", m)
+	}
+
+	if required, ok := s["required"].([]any); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, ok := obj[name]; !ok {
+					st.errorf(loc, "required", instPath, "missing property %q", name)
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	if deps, ok := s["dependencies"].(map[string]any); ok {
+		for _, k := range keys {
+			switch dep := deps[k].(type) {
+			case nil:
+			case []any:
+				for _, d := range dep {
+					if name, ok := d.(string); ok {
+						if _, ok := obj[name]; !ok {
+							st.errorf(loc.with("dependencies"), k, instPath, "property %q requires property %q", k, name)
+						}
+					}
+				}
+			default:
+				st.validate(loc.with("dependencies", k), dep, instPath, obj)
+			}
+		}
+	}
+
+	if names, ok := s["propertyNames"]; ok {
+		for _, k := range keys {
+			st.validate(loc.with("propertyNames"), names, propertyPath(instPath, k), k)
+		}
+	}
+
+	props, _ := s["properties"].(map[string]any)
+	patternProps, _ := s["patternProperties"].(map[string]any)
+	additional, hasAdditional := s["additionalProperties"]
+	for _, k := range keys {
+		matched := false
+		if ps, ok := props[k]; ok {
+			matched = true
+			st.validate(loc.with("properties", k), ps, propertyPath(instPath, k), obj[k])
+		}
+		for p, ps := range patternProps {
+			if st.match(p, k) {
+				matched = true
+				st.validate(loc.with("patternProperties", p), ps, propertyPath(instPath, k), obj[k])
+			}
+		}
+		if !matched && hasAdditional {
+			st.validate(loc.with("additionalProperties"), additional, propertyPath(instPath, k), obj[k])
+		}
+	}
+}
+
+func itemPath(p string, i int) string {
+	return p + "/" + strconv.Itoa(i)
+}
+
+func propertyPath(p, k string) string {
+	return location{pointer: p}.with(k).pointer
+}
+
+func count(v any) (int, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return int(i), err == nil
+}
+
+func hasType(inst any, t string) bool {
+	switch t {
+	case "integer":
+		n, ok := inst.(json.Number)
+		if !ok {
+			return false
+		}
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f)
+	case "number":
+		_, ok := inst.(json.Number)
+		return ok
+	}
+	return typeOf(inst) == t
+}
+
+func typeOf(inst any) string {
+	switch inst.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", inst)
+}
+
+// equal reports whether a and b are equal JSON values. Numbers are compared by value.
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		bn, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		af, _ := a.Float64()
+		bf, _ := bn.Float64()
+		return af == bf
+	case []any:
+		ba, ok := b.([]any)
+		return ok && slices.EqualFunc(a, ba, equal)
+	case map[string]any:
+		bm, ok := b.(map[string]any)
+		if !ok || len(a) != len(bm) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := bm[k]
+			if !ok || !equal(av, bv) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
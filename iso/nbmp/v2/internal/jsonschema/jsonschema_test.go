@@ -0,0 +1,192 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonschema_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/nagare-media/models.go/iso/nbmp/v2/internal/jsonschema"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		schema string
+		valid  []string
+		// instances with the expected number of errors
+		invalid map[string]int
+	}{
+		{`{"type": "integer"}`, []string{`1`, `1.0`, `-3`}, map[string]int{`1.5`: 1, `"1"`: 1, `null`: 1}},
+		{`{"type": ["string", "null"]}`, []string{`"a"`, `null`}, map[string]int{`true`: 1}},
+		{
+			`{"enum": [1, "a", {"b": [2]}]}`,
+			[]string{`1.0`, `"a"`, `{"b": [2]}`},
+			map[string]int{`2`: 1, `{"b": []}`: 1},
+		},
+		{`{"const": false}`, []string{`false`}, map[string]int{`0`: 1}},
+		{
+			`{"minimum": 1, "exclusiveMaximum": 3, "multipleOf": 0.5}`,
+			[]string{`1`, `2.5`},
+			map[string]int{`0`: 1, `3`: 1, `1.25`: 1},
+		},
+		{
+			`{"minLength": 2, "maxLength": 3, "pattern": "^a"}`,
+			[]string{`"ab"`, `"aää"`},
+			map[string]int{`"a"`: 1, `"bbbb"`: 2},
+		},
+		{`{"format": "uri"}`, []string{`"urn:a:b"`, `1`}, map[string]int{`"/relative"`: 1}},
+		{`{"format": "date-time"}`, []string{`"2025-01-02T03:04:05Z"`}, map[string]int{`"2025-01-02"`: 1}},
+		{
+			`{"minItems": 1, "maxItems": 2, "uniqueItems": true}`,
+			[]string{`[1]`, `[1, 2]`},
+			map[string]int{`[]`: 1, `[1, 1.0]`: 1, `[1, 2, 3]`: 1},
+		},
+		{
+			`{"items": [{"type": "string"}], "additionalItems": false}`,
+			[]string{`[]`, `["a"]`},
+			map[string]int{`[1, 2]`: 2},
+		},
+		{
+			`{"items": {"type": "string"}, "contains": {"const": "a"}}`,
+			[]string{`["b", "a"]`},
+			map[string]int{`["b", 1]`: 2},
+		},
+		{
+			`{"required": ["a"], "properties": {"a": {"type": "string"}}, "patternProperties": {"^x-": {"type": "number"}},
+				"additionalProperties": false}`,
+			[]string{`{"a": "a", "x-b": 1}`},
+			map[string]int{`{}`: 1, `{"a": 1, "b": 2, "x-c": "c"}`: 3},
+		},
+		{
+			`{"minProperties": 1, "maxProperties": 1, "propertyNames": {"maxLength": 1}}`,
+			[]string{`{"a": 1}`},
+			map[string]int{`{}`: 1, `{"ab": 1, "c": 2}`: 2},
+		},
+		{
+			`{"dependencies": {"a": ["b"], "c": {"required": ["d"]}}}`,
+			[]string{`{"a": 1, "b": 2}`, `{"b": 1}`},
+			map[string]int{`{"a": 1, "c": 2}`: 2},
+		},
+		{`{"allOf": [{"minimum": 1}, {"maximum": 2}]}`, []string{`1`}, map[string]int{`3`: 1}},
+		{`{"anyOf": [{"type": "string"}, {"minimum": 1}]}`, []string{`"a"`, `1`}, map[string]int{`0`: 1}},
+		{`{"oneOf": [{"type": "integer"}, {"minimum": 1}]}`, []string{`0`, `1.5`}, map[string]int{`1`: 1, `0.5`: 1}},
+		{`{"not": {"type": "string"}}`, []string{`1`}, map[string]int{`"a"`: 1}},
+		{
+			`{"if": {"minimum": 1}, "then": {"multipleOf": 2}, "else": {"const": 0}}`,
+			[]string{`2`, `0`},
+			map[string]int{`3`: 1, `-1`: 1},
+		},
+		{
+			`{"$ref": "#/definitions/a", "type": "string", "definitions": {"a": {"type": "integer"}}}`,
+			[]string{`1`},
+			map[string]int{`"a"`: 1},
+		},
+		{`{"$ref": "other.json#/b"}`, []string{`true`}, map[string]int{`1`: 1}},
+		{`{"$ref": "#/redirected", "definitions": {"a": {"type": "object"}}}`, []string{`{}`}, map[string]int{`1`: 1}},
+		{`{"properties": {"a": true, "b": false}}`, []string{`{"a": 1}`}, map[string]int{`{"b": 1}`: 1}},
+	}
+
+	for _, tc := range tests {
+		v, err := jsonschema.New(fstest.MapFS{
+			"schema.json": {Data: []byte(tc.schema)},
+			"other.json":  {Data: []byte(`{"b": {"type": "boolean"}}`)},
+		}, map[string]string{"#/redirected": "#/definitions/a"})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.schema, err)
+			continue
+		}
+
+		for _, inst := range tc.valid {
+			errs, err := v.Validate("schema.json", []byte(inst))
+			if err != nil || len(errs) != 0 {
+				t.Errorf("%s: expected %s to be valid, got %v %v", tc.schema, inst, err, errs)
+			}
+		}
+		for inst, n := range tc.invalid {
+			errs, err := v.Validate("schema.json", []byte(inst))
+			if err != nil || len(errs) != n {
+				t.Errorf("%s: expected %d errors for %s, got %v %v", tc.schema, n, inst, err, errs)
+			}
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, schema := range []string{
+		`{"$ref": "#/missing"}`,
+		`{"$ref": "missing.json"}`,
+		`{"pattern": "("}`,
+		`{`,
+	} {
+		if _, err := jsonschema.New(fstest.MapFS{"schema.json": {Data: []byte(schema)}}, nil); err == nil {
+			t.Errorf("%s: expected error", schema)
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	v, err := jsonschema.Compile([]byte(`{"type": "array", "items": {"$ref": "#/definitions/int"},
+		"definitions": {"int": {"type": "integer"}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if errs, err := v.Validate("", []byte(`[1, 2]`)); err != nil || len(errs) != 0 {
+		t.Errorf("expected valid instance, got %v %v", err, errs)
+	}
+	if errs, err := v.Validate("", []byte(`[1, "2"]`)); err != nil || len(errs) != 1 || errs[0].InstancePath != "/1" {
+		t.Errorf("expected one error at /1, got %v %v", err, errs)
+	}
+	if _, err := jsonschema.Compile([]byte(`{"$ref": "other.json"}`)); err == nil {
+		t.Error("expected error for unresolvable reference")
+	}
+}
+
+func TestCompileSchemaKeywordsOnly(t *testing.T) {
+	// "default" is a property name here, not the keyword
+	v, err := jsonschema.Compile([]byte(`{"items": {"properties": {"default": {"type": "string", "pattern": "^x$"}}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	errs, err := v.Validate("", []byte(`[{"default": "y"}]`))
+	if err != nil || len(errs) != 1 || errs[0].InstancePath != "/0/default" {
+		t.Errorf("expected one error at /0/default, got %v %v", err, errs)
+	}
+
+	// patterns in values of annotation keywords are not compiled
+	if _, err := jsonschema.Compile([]byte(`{"default": {"pattern": "("}, "properties": {"pattern": {}}}`)); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestValidateInfiniteRecursion(t *testing.T) {
+	v, err := jsonschema.Compile([]byte(`{"allOf": [{"$ref": "#"}]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := v.Validate("", []byte(`[1]`)); err == nil {
+		t.Error("expected error for infinite recursion")
+	}
+
+	// recursion consuming the instance terminates
+	v, err = jsonschema.Compile([]byte(`{"type": "array", "items": {"anyOf": [{"type": "integer"}, {"$ref": "#"}]}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if errs, err := v.Validate("", []byte(`[1, [2, [3]], ["x"]]`)); err != nil || len(errs) != 1 || errs[0].InstancePath != "/2" {
+		t.Errorf("expected one error at /2, got %v %v", err, errs)
+	}
+}
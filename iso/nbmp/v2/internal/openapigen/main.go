@@ -0,0 +1,417 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// openapigen generates the OpenAPI v3 schemas of the NBMP description documents from the Go types. The schemas are
+// structural as required for Kubernetes custom resource definitions, i.e. they do not use references and every node
+// has a type or preserves unknown fields. Recursive types, e.g. the function restrictions of processing descriptors,
+// preserve unknown fields at the point of recursion. Descriptions are taken from the doc comments of the Go types.
+//
+// It is run by go generate in the directory of the nbmp package and writes the schemas into the openapi directory.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nagare-media/models.go/base"
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+// documents are the generated schemas by file name.
+var documents = []struct {
+	file string
+	typ  reflect.Type
+}{
+	{"function.json", reflect.TypeOf(nbmp.Function{})},
+	{"task.json", reflect.TypeOf(nbmp.Task{})},
+	{"workflow.json", reflect.TypeOf(nbmp.Workflow{})},
+}
+
+// implementations lists the types that can be the dynamic type of an interface.
+var implementations = map[reflect.Type][]reflect.Type{
+	reflect.TypeOf((*nbmp.ParameterValue)(nil)).Elem(): {
+		reflect.TypeOf(nbmp.BooleanParameterValue{}),
+		reflect.TypeOf(nbmp.IntegerParameterValue{}),
+		reflect.TypeOf(nbmp.NumberParameterValue{}),
+		reflect.TypeOf(nbmp.StringParameterValue{}),
+		reflect.TypeOf(nbmp.ArrayParameterValue{}),
+	},
+}
+
+var (
+	rawJSONType = reflect.TypeOf(nbmp.RawJSON{})
+	timeType    = reflect.TypeOf(time.Time{})
+	uriType     = reflect.TypeOf(base.URI(""))
+)
+
+// Schema is the subset of the OpenAPI v3 schema object used by the generated schemas.
+type Schema struct {
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PreserveUnknown      bool               `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the nbmp package")
+	flag.Parse()
+
+	files, err := generate(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "openapigen: %s\n", err)
+		os.Exit(1)
+	}
+	for name, b := range files {
+		if err := os.WriteFile(filepath.Join(*dir, "openapi", name), b, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "openapigen: %s\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the encoded schemas by file name.
+func generate(dir string) (map[string][]byte, error) {
+	docs, err := parseDocs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(documents))
+	for _, d := range documents {
+		g := &generator{docs: docs}
+		s, err := g.schema(d.typ)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.typ, err)
+		}
+		b, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files[d.file] = append(b, '\n')
+	}
+	return files, nil
+}
+
+type generator struct {
+	docs docs
+
+	// struct types that are currently generated to detect recursive types
+	stack []reflect.Type
+}
+
+func (g *generator) schema(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case rawJSONType:
+		return &Schema{PreserveUnknown: true}, nil
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case uriType:
+		return &Schema{Type: "string", Format: "uri"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}, nil
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32", Minimum: new(float64)}, nil
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64", Minimum: new(float64)}, nil
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %s", t.Key())
+		}
+		values, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Interface:
+		return g.interfaceSchema(t)
+	case reflect.Struct:
+		return g.structSchema(t)
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func (g *generator) structSchema(t reflect.Type) (*Schema, error) {
+	if slices.Contains(g.stack, t) {
+		// structural schemas cannot be recursive: the nested value is not pruned, but also not validated
+		return &Schema{Description: g.docs.typeDoc(t), Type: "object", PreserveUnknown: true}, nil
+	}
+	g.stack = append(g.stack, t)
+	defer func() { g.stack = g.stack[:len(g.stack)-1] }()
+
+	s := &Schema{
+		Description: g.docs.typeDoc(t),
+		Type:        "object",
+		Properties:  make(map[string]*Schema),
+	}
+	if err := g.addFields(s, t); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (g *generator) addFields(s *Schema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := g.addFields(s, ft); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fs, err := g.schema(f.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+		doc, optional := g.docs.fieldDoc(t, f.Name)
+		if doc != "" {
+			fs.Description = doc
+		}
+		s.Properties[name] = fs
+
+		if !optional && !slices.Contains(strings.Split(opts, ","), "omitempty") {
+			s.Required = append(s.Required, name)
+		}
+	}
+	return nil
+}
+
+// interfaceSchema merges the schemas of the implementations of t. Properties with different schemas preserve unknown
+// fields. Only properties required by all implementations are required.
+func (g *generator) interfaceSchema(t reflect.Type) (*Schema, error) {
+	impls, ok := implementations[t]
+	if !ok {
+		return nil, fmt.Errorf("unsupported interface type %s", t)
+	}
+
+	var s *Schema
+	for _, impl := range impls {
+		is, err := g.structSchema(impl)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			s = is
+			s.Description = g.docs.typeDoc(t)
+			continue
+		}
+		for name, ps := range s.Properties {
+			ips, ok := is.Properties[name]
+			if !ok || !sameSchema(ps, ips) {
+				s.Properties[name] = &Schema{Description: ps.Description, PreserveUnknown: true}
+			}
+		}
+		for name, ips := range is.Properties {
+			if _, ok := s.Properties[name]; !ok {
+				s.Properties[name] = &Schema{Description: ips.Description, PreserveUnknown: true}
+			}
+		}
+		s.Required = slices.DeleteFunc(s.Required, func(name string) bool {
+			return !slices.Contains(is.Required, name)
+		})
+	}
+	return s, nil
+}
+
+func sameSchema(a, b *Schema) bool {
+	ab, _ := json.Marshal(a)
+	bb, _ := json.Marshal(b)
+	return bytes.Equal(ab, bb)
+}
+
+// docs are the doc comments of the types of a package and their fields.
+type docs map[string]*typeDocs
+
+type typeDocs struct {
+	doc    string
+	fields map[string]string
+
+	// fields with the +optional marker
+	optional map[string]bool
+}
+
+func (d docs) typeDoc(t reflect.Type) string {
+	if td, ok := d[t.Name()]; ok && t.PkgPath() == nbmpPkgPath {
+		return td.doc
+	}
+	return ""
+}
+
+func (d docs) fieldDoc(t reflect.Type, field string) (doc string, optional bool) {
+	if td, ok := d[t.Name()]; ok && t.PkgPath() == nbmpPkgPath {
+		return td.fields[field], td.optional[field]
+	}
+	return "", false
+}
+
+var nbmpPkgPath = reflect.TypeOf(nbmp.Workflow{}).PkgPath()
+
+// parseDocs parses the doc comments of the Go files in dir.
+func parseDocs(dir string) (docs, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	d := make(docs)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					doc := ts.Doc
+					if doc == nil && len(gd.Specs) == 1 {
+						doc = gd.Doc
+					}
+					td := &typeDocs{
+						doc:      description(doc),
+						fields:   make(map[string]string),
+						optional: make(map[string]bool),
+					}
+					if st, ok := ts.Type.(*ast.StructType); ok {
+						for _, field := range st.Fields.List {
+							for _, name := range field.Names {
+								td.fields[name.Name] = description(field.Doc)
+								td.optional[name.Name] = hasMarker(field.Doc, "+optional")
+							}
+						}
+					}
+					d[ts.Name.Name] = td
+				}
+			}
+		}
+	}
+	return d, nil
+}
+
+// description returns the text of a doc comment without markers and TODO notes. Lines of a paragraph are joined.
+func description(cg *ast.CommentGroup) string {
+	if cg == nil {
+		return ""
+	}
+
+	var paragraphs []string
+	var paragraph []string
+	inTODO := false
+	flush := func() {
+		if len(paragraph) > 0 {
+			paragraphs = append(paragraphs, strings.Join(paragraph, " "))
+			paragraph = nil
+		}
+	}
+	for _, line := range strings.Split(cg.Text(), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "TODO"):
+			inTODO = true
+			continue
+		case inTODO && trimmed != "" && line != trimmed:
+			// indented continuation of a TODO note
+			continue
+		}
+		inTODO = false
+
+		switch {
+		case trimmed == "":
+			flush()
+		case strings.HasPrefix(trimmed, "+"):
+			// marker
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func hasMarker(cg *ast.CommentGroup, marker string) bool {
+	if cg == nil {
+		return false
+	}
+	for _, line := range strings.Split(cg.Text(), "\n") {
+		if strings.TrimSpace(line) == marker {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGeneratedSchemasUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	files, err := generate(dir)
+	if err != nil {
+		t.Fatalf("generate failed: %s", err)
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, "openapi", name))
+		if err != nil {
+			t.Fatalf("%s: reading file failed: %s", name, err)
+		}
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("%s: out of date, run go generate (-want +got):\n%s", name, diff)
+		}
+	}
+}
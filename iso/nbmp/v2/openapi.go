@@ -0,0 +1,52 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"embed"
+)
+
+//go:generate go run ./internal/openapigen
+
+// The OpenAPI v3 schemas are generated from the Go types. They are structural as required for Kubernetes custom
+// resource definitions and can be embedded as openAPIV3Schema of a CRD version or as schema of a property.
+//
+//go:embed openapi/*.json
+var openAPISchemas embed.FS
+
+// WorkflowOpenAPISchema returns the OpenAPI v3 schema of Workflow as JSON.
+func WorkflowOpenAPISchema() []byte {
+	return openAPISchema("workflow.json")
+}
+
+// TaskOpenAPISchema returns the OpenAPI v3 schema of Task as JSON.
+func TaskOpenAPISchema() []byte {
+	return openAPISchema("task.json")
+}
+
+// FunctionOpenAPISchema returns the OpenAPI v3 schema of Function as JSON.
+func FunctionOpenAPISchema() []byte {
+	return openAPISchema("function.json")
+}
+
+func openAPISchema(name string) []byte {
+	b, err := openAPISchemas.ReadFile("openapi/" + name)
+	if err != nil {
+		panic("openAPISchema: " + err.Error())
+	}
+	return b
+}
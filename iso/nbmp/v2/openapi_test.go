@@ -0,0 +1,74 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+type schema struct {
+	Type                 string             `json:"type"`
+	Ref                  string             `json:"$ref"`
+	Items                *schema            `json:"items"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties *schema            `json:"additionalProperties"`
+	PreserveUnknown      bool               `json:"x-kubernetes-preserve-unknown-fields"`
+}
+
+func TestOpenAPISchemasStructural(t *testing.T) {
+	schemas := map[string][]byte{
+		"Workflow": nbmp.WorkflowOpenAPISchema(),
+		"Task":     nbmp.TaskOpenAPISchema(),
+		"Function": nbmp.FunctionOpenAPISchema(),
+	}
+
+	for name, b := range schemas {
+		s := &schema{}
+		if err := json.Unmarshal(b, s); err != nil {
+			t.Fatalf("%s: unmarshal failed: %s", name, err)
+		}
+		if s.Type != "object" {
+			t.Errorf("%s: expected type object, got %q", name, s.Type)
+		}
+		if s.Properties["general"] == nil {
+			t.Errorf("%s: general property is missing", name)
+		}
+
+		var walk func(path string, s *schema)
+		walk = func(path string, s *schema) {
+			if s.Ref != "" {
+				t.Errorf("%s%s: unexpected reference %q", name, path, s.Ref)
+			}
+			if s.Type == "" && !s.PreserveUnknown {
+				t.Errorf("%s%s: type is missing", name, path)
+			}
+			for p, ps := range s.Properties {
+				walk(path+"."+p, ps)
+			}
+			if s.Items != nil {
+				walk(path+"[]", s.Items)
+			}
+			if s.AdditionalProperties != nil {
+				walk(path+"{}", s.AdditionalProperties)
+			}
+		}
+		walk("", s)
+	}
+}
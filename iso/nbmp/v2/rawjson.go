@@ -0,0 +1,92 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// RawJSON is an arbitrary JSON value, e.g. a free-form JSON object, that is kept in its encoded form. It is used instead
+// of map[string]interface{} so that the containing types can be deep copied and have a structural OpenAPI schema, i.e.
+// one that can be used in Kubernetes custom resource definitions. A nil or empty RawJSON is marshalled as null and is
+// omitted by omitempty.
+type RawJSON []byte
+
+var (
+	_ json.Marshaler   = RawJSON{}
+	_ json.Unmarshaler = &RawJSON{}
+)
+
+// NewRawJSON returns the JSON encoding of v.
+func NewRawJSON(v any) (RawJSON, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return compactRawJSON(b)
+}
+
+// Decode decodes the JSON value into v. It is a no-op if r is empty.
+func (r RawJSON) Decode(v any) error {
+	if len(r) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r, v)
+}
+
+// Object decodes the JSON value as JSON object. It returns nil if r is empty or null.
+func (r RawJSON) Object() (map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := r.Decode(&m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (r RawJSON) MarshalJSON() ([]byte, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+	return r, nil
+}
+
+func (r *RawJSON) UnmarshalJSON(data []byte) error {
+	if r == nil {
+		return errors.New("RawJSON.UnmarshalJSON: on nil pointer")
+	}
+	if bytes.Equal(data, []byte("null")) {
+		*r = nil
+		return nil
+	}
+	raw, err := compactRawJSON(data)
+	if err != nil {
+		return err
+	}
+	*r = raw
+	return nil
+}
+
+// compactRawJSON returns a compacted copy of data so that equal values have (mostly) equal encodings.
+func compactRawJSON(data []byte) (RawJSON, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
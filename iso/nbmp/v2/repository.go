@@ -0,0 +1,100 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+)
+
+// ErrFunctionNotFound is returned by function repositories that do not contain a function.
+var ErrFunctionNotFound = errors.New("function not found")
+
+// FunctionRepository provides function description documents.
+// +kubebuilder:object:generate=false
+type FunctionRepository interface {
+	// Function returns the function with the given ID. It returns an error wrapping ErrFunctionNotFound if the
+	// repository does not contain the function. Callers must not modify the returned function.
+	Function(ctx context.Context, id string) (*Function, error)
+}
+
+// MemoryFunctionRepository is a function repository backed by a map of functions by ID.
+// +kubebuilder:object:generate=false
+type MemoryFunctionRepository map[string]*Function
+
+var _ FunctionRepository = MemoryFunctionRepository{}
+
+func (r MemoryFunctionRepository) Function(ctx context.Context, id string) (*Function, error) {
+	if fn, ok := r[id]; ok {
+		return fn, nil
+	}
+	return nil, fmt.Errorf("MemoryFunctionRepository.Function: %w: %q", ErrFunctionNotFound, id)
+}
+
+// NewFSFunctionRepository returns a function repository containing the function description documents with the
+// extension FunctionDescriptionDocumentExt in fsys and its subdirectories. Functions are identified by the ID of their
+// general descriptor. The documents are read once.
+func NewFSFunctionRepository(fsys fs.FS) (MemoryFunctionRepository, error) {
+	r := make(MemoryFunctionRepository)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != "."+FunctionDescriptionDocumentExt {
+			return err
+		}
+
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		fn := &Function{}
+		if err := json.Unmarshal(b, fn); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if _, ok := r[fn.General.ID]; ok {
+			return fmt.Errorf("%s: duplicate function %q", name, fn.General.ID)
+		}
+		r[fn.General.ID] = fn
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("NewFSFunctionRepository: %w", err)
+	}
+	return r, nil
+}
+
+// OpenFileFunctionRepository opens the function repository of a repository location with a file URL, e.g.
+// "file:///srv/functions", as filesystem-backed repository (see NewFSFunctionRepository). It can be used as
+// Expander.Open.
+func OpenFileFunctionRepository(ctx context.Context, loc RepositoryLocation) (FunctionRepository, error) {
+	u, err := url.Parse(string(loc.URL))
+	if err != nil {
+		return nil, fmt.Errorf("OpenFileFunctionRepository: %w", err)
+	}
+	if u.Scheme != "file" {
+		return nil, fmt.Errorf("OpenFileFunctionRepository: unsupported URL scheme %q", u.Scheme)
+	}
+	r, err := NewFSFunctionRepository(os.DirFS(u.Path))
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strings"
+	"sync"
+
+	"github.com/nagare-media/models.go/iso/nbmp/v2/internal/jsonschema"
+)
+
+// The normative JSON schemas of NBMP (see schemas/README.md).
+//
+//go:embed schemas/*.json
+var schemas embed.FS
+
+// schemaRefFixes maps erroneous references of the vendored schemas to the intended ones.
+var schemaRefFixes = map[string]string{
+	"#/configuration/parameters":       "#/configuration/properties/parameters",
+	"#/requirement/flowcontrol":        "#/requirement/properties/flowcontrol",
+	"#/requirement/hardware/placement": "#/requirement/properties/hardware/properties/placement",
+	"#/requirements":                   "#/requirement",
+}
+
+var schemaValidator = sync.OnceValue(func() *jsonschema.Validator {
+	fsys, err := fs.Sub(schemas, "schemas")
+	if err != nil {
+		panic("schemaValidator: " + err.Error())
+	}
+	v, err := jsonschema.New(fsys, schemaRefFixes)
+	if err != nil {
+		panic("schemaValidator: " + err.Error())
+	}
+	return v
+})
+
+// SchemaError is a violation of an NBMP JSON schema.
+// +kubebuilder:object:generate=false
+type SchemaError struct {
+	// JSON pointer to the violating value in the document, e.g. "/general/id". Empty for the document itself.
+	InstancePath string
+
+	// Location of the violated keyword in the schemas, e.g. "nbmp-schema-definitions.json#/general/required".
+	SchemaPath string
+
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	p := e.InstancePath
+	if p == "" {
+		p = "/"
+	}
+	return p + ": " + e.Message
+}
+
+// SchemaErrors lists all violations of a document.
+// +kubebuilder:object:generate=false
+type SchemaErrors []*SchemaError
+
+func (e SchemaErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "schema violations: " + strings.Join(msgs, "; ")
+}
+
+// ValidateWorkflow validates the workflow description document raw against the NBMP workflow schema. It returns
+// SchemaErrors if raw violates the schema.
+func ValidateWorkflow(raw []byte) error {
+	return validateSchema("ValidateWorkflow", "nbmp-workflow-schema.json", raw)
+}
+
+// ValidateTask validates the task description document raw against the NBMP task schema. It returns SchemaErrors if
+// raw violates the schema.
+func ValidateTask(raw []byte) error {
+	return validateSchema("ValidateTask", "nbmp-task-schema.json", raw)
+}
+
+// ValidateFunction validates the function description document raw against the NBMP function schema. It returns
+// SchemaErrors if raw violates the schema.
+func ValidateFunction(raw []byte) error {
+	return validateSchema("ValidateFunction", "nbmp-function-schema.json", raw)
+}
+
+// ValidateMPECapabilities validates the MPE capabilities description document raw against the NBMP MPE capabilities
+// schema. It returns SchemaErrors if raw violates the schema.
+func ValidateMPECapabilities(raw []byte) error {
+	return validateSchema("ValidateMPECapabilities", "nbmp-mpecapabilities-schema.json", raw)
+}
+
+func validateSchema(fn, schema string, raw []byte) error {
+	errs, err := schemaValidator().Validate(schema, raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", fn, err)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	schemaErrs := make(SchemaErrors, len(errs))
+	for i, e := range errs {
+		schemaErrs[i] = &SchemaError{
+			InstancePath: e.InstancePath,
+			SchemaPath:   e.SchemaPath,
+			Message:      e.Message,
+		}
+	}
+	return schemaErrs
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+const validFunction = `{
+  "general": {
+    "id": "urn:nagare-media:function:fifo",
+    "name": "fifo",
+    "description": "FIFO buffer",
+    "state": "instantiated",
+    "is-group": false,
+    "input-ports": [{"port-name": "in", "bind": {"stream-id": "in", "name": "in"}}],
+    "output-ports": [{"port-name": "out", "bind": {"stream-id": "out", "name": "out"}}]
+  },
+  "input": {
+    "media-parameters": [{
+      "stream-id": "in",
+      "name": "in",
+      "keywords": ["video"],
+      "mime-type": "video/mp4",
+      "protocol": "http",
+      "caching-server-url": "http://example.com/in"
+    }]
+  },
+  "output": {
+    "media-parameters": [{
+      "stream-id": "out",
+      "name": "out",
+      "keywords": ["video"],
+      "mime-type": "video/mp4",
+      "protocol": "http",
+      "caching-server-url": "http://example.com/out"
+    }]
+  }
+}`
+
+func TestValidateFunction(t *testing.T) {
+	if err := nbmp.ValidateFunction([]byte(validFunction)); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestValidateSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func([]byte) error
+		raw      string
+		want     nbmp.SchemaErrors
+	}{
+		{
+			name:     "function without input and output",
+			validate: nbmp.ValidateFunction,
+			raw: `{"general": {"id": "a", "name": "a", "description": "a", "state": "instantiated",
+				"input-ports": [], "output-ports": []}}`,
+			want: nbmp.SchemaErrors{
+				{
+					InstancePath: "",
+					SchemaPath:   "nbmp-function-schema.json#/required",
+					Message:      `missing property "input"`,
+				},
+				{
+					InstancePath: "",
+					SchemaPath:   "nbmp-function-schema.json#/required",
+					Message:      `missing property "output"`,
+				},
+				{
+					InstancePath: "/general/input-ports",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/input-ports/minItems",
+					Message:      "array has fewer than 1 items",
+				},
+				{
+					InstancePath: "/general/output-ports",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/output-ports/minItems",
+					Message:      "array has fewer than 1 items",
+				},
+			},
+		},
+		{
+			name:     "MPE capabilities with invalid brand and time",
+			validate: nbmp.ValidateMPECapabilities,
+			raw: `{"general": {"id": "a", "name": "a", "description": "a", "state": "instantiated",
+				"nbmp-brand": "urn:example:brand", "published-time": "yesterday", "input-ports": [{}], "output-ports": [{}]}}`,
+			want: nbmp.SchemaErrors{
+				{
+					InstancePath: "/general/input-ports/0",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/input-ports/items/required",
+					Message:      `missing property "port-name"`,
+				},
+				{
+					InstancePath: "/general/input-ports/0",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/input-ports/items/required",
+					Message:      `missing property "bind"`,
+				},
+				{
+					InstancePath: "/general/nbmp-brand",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/nbmp-brand/pattern",
+					Message:      `"urn:example:brand" does not match pattern "^urn:mpeg:mpegi:nbmp:(2([0-9]{3})):([a-zA-Z0-9_]+)$"`,
+				},
+				{
+					InstancePath: "/general/output-ports/0",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/output-ports/items/required",
+					Message:      `missing property "port-name"`,
+				},
+				{
+					InstancePath: "/general/output-ports/0",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/output-ports/items/required",
+					Message:      `missing property "bind"`,
+				},
+				{
+					InstancePath: "/general/published-time",
+					SchemaPath:   "nbmp-schema-definitions.json#/general/properties/published-time/format",
+					Message:      `"yesterday" is not an RFC 3339 date-time`,
+				},
+			},
+		},
+		{
+			name:     "task with wrong types",
+			validate: nbmp.ValidateTask,
+			raw:      `[]`,
+			want: nbmp.SchemaErrors{{
+				InstancePath: "",
+				SchemaPath:   "nbmp-task-schema.json#/type",
+				Message:      "expected object, got array",
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		err := tc.validate([]byte(tc.raw))
+		var got nbmp.SchemaErrors
+		if !errors.As(err, &got) {
+			t.Errorf("%s: expected SchemaErrors, got %v", tc.name, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("%s: unexpected errors (-want +got):\n%s", tc.name, diff)
+		}
+	}
+}
+
+func TestValidateWorkflowInvalidJSON(t *testing.T) {
+	err := nbmp.ValidateWorkflow([]byte(`{"general": `))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	var schemaErrs nbmp.SchemaErrors
+	if errors.As(err, &schemaErrs) {
+		t.Errorf("expected syntax error, got %s", err)
+	}
+}
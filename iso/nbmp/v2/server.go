@@ -0,0 +1,271 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Server is an in-memory reference implementation of the Workflow API, the Task API and the Function Discovery API.
+// It stores description documents without deploying any tasks and is intended for testing NBMP clients, e.g. with
+// httptest.NewServer. The APIs are served relative to the root path; use http.StripPrefix to serve them from a
+// different base URL.
+//
+// Created documents without ID are assigned a new ID. Documents are validated with Validate; invalid documents are
+// rejected with status 400 and an acknowledge descriptor listing the failed fields. Updates replace the stored
+// document; descriptors are not merged.
+// +kubebuilder:object:generate=false
+type Server struct {
+	functions MemoryFunctionRepository
+
+	mu     sync.Mutex
+	nextID int
+
+	mux *http.ServeMux
+}
+
+var _ http.Handler = &Server{}
+
+// resource is a collection of description documents managed by Server.
+// +kubebuilder:object:generate=false
+type resource struct {
+	kind     DocumentKind
+	mimeType string
+	name     string
+	new      func() descriptionDocument
+	docs     map[string]descriptionDocument
+}
+
+// descriptionDocument is implemented by the description documents managed by Server.
+// +kubebuilder:object:generate=false
+type descriptionDocument interface {
+	Validate(kind DocumentKind, opts ...ValidateOption) error
+	generalDescriptor() *General
+	setAcknowledge(a *Acknowledge)
+	deepCopyDocument() descriptionDocument
+}
+
+func (w *Workflow) generalDescriptor() *General           { return &w.General }
+func (w *Workflow) setAcknowledge(a *Acknowledge)         { w.Acknowledge = a }
+func (w *Workflow) deepCopyDocument() descriptionDocument { return w.DeepCopy() }
+
+func (t *Task) generalDescriptor() *General           { return &t.General }
+func (t *Task) setAcknowledge(a *Acknowledge)         { t.Acknowledge = a }
+func (t *Task) deepCopyDocument() descriptionDocument { return t.DeepCopy() }
+
+// NewServer returns a server without workflows and tasks. functions are provided by the Function Discovery API.
+func NewServer(functions MemoryFunctionRepository) *Server {
+	workflows := &resource{
+		kind:     WorkflowDescriptionDocumentKind,
+		mimeType: WorkflowDescriptionDocumentMIMEType,
+		name:     "workflow",
+		new:      func() descriptionDocument { return &Workflow{} },
+		docs:     make(map[string]descriptionDocument),
+	}
+	tasks := &resource{
+		kind:     TaskDescriptionDocumentKind,
+		mimeType: TaskDescriptionDocumentMIMEType,
+		name:     "task",
+		new:      func() descriptionDocument { return &Task{} },
+		docs:     make(map[string]descriptionDocument),
+	}
+	s := &Server{
+		functions: functions,
+		mux:       http.NewServeMux(),
+	}
+
+	handlers := map[Operation]http.HandlerFunc{
+		CreateWorkflowOperation:    s.create(workflows),
+		UpdateWorkflowOperation:    s.update(workflows),
+		GetWorkflowOperation:       s.get(workflows),
+		DeleteWorkflowOperation:    s.delete(workflows),
+		CreateTaskOperation:        s.create(tasks),
+		UpdateTaskOperation:        s.update(tasks),
+		GetTaskOperation:           s.get(tasks),
+		DeleteTaskOperation:        s.delete(tasks),
+		DiscoverFunctionsOperation: s.discoverFunctions,
+	}
+	for op, h := range handlers {
+		e := endpoints[op]
+		pattern := e.method + " /" + e.path
+		if e.id {
+			pattern += "/{id}"
+		}
+		s.mux.Handle(pattern, h)
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) create(res *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		d, ok := readDocument(w, r, res)
+		if !ok {
+			return
+		}
+		g := d.generalDescriptor()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if g.ID == "" {
+			s.nextID++
+			g.ID = fmt.Sprintf("%s-%d", res.name, s.nextID)
+		}
+		if _, ok := res.docs[g.ID]; ok {
+			http.Error(w, fmt.Sprintf("%s %q already exists", res.name, g.ID), http.StatusConflict)
+			return
+		}
+		if g.State == nil {
+			g.State = ptr(InstantiatedState)
+		}
+		d.setAcknowledge(&Acknowledge{Status: FulfilledAcknowledgeStatus})
+		res.docs[g.ID] = d.deepCopyDocument()
+
+		w.Header().Set("Location", path.Join(r.URL.Path, url.PathEscape(g.ID)))
+		writeDocument(w, http.StatusCreated, res.mimeType, d)
+	}
+}
+
+func (s *Server) update(res *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		d, ok := readDocument(w, r, res)
+		if !ok {
+			return
+		}
+		g := d.generalDescriptor()
+		if g.ID == "" {
+			g.ID = id
+		}
+		if g.ID != id {
+			http.Error(w, fmt.Sprintf("ID %q does not match %s %q", g.ID, res.name, id), http.StatusBadRequest)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		old, ok := res.docs[id]
+		if !ok {
+			http.Error(w, fmt.Sprintf("%s %q not found", res.name, id), http.StatusNotFound)
+			return
+		}
+		if g.State == nil {
+			g.State = old.generalDescriptor().State
+		}
+		d.setAcknowledge(&Acknowledge{Status: FulfilledAcknowledgeStatus})
+		res.docs[id] = d.deepCopyDocument()
+		writeDocument(w, http.StatusOK, res.mimeType, d)
+	}
+}
+
+func (s *Server) get(res *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		d, ok := res.docs[id]
+		if !ok {
+			http.Error(w, fmt.Sprintf("%s %q not found", res.name, id), http.StatusNotFound)
+			return
+		}
+		writeDocument(w, http.StatusOK, res.mimeType, d)
+	}
+}
+
+func (s *Server) delete(res *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		d, ok := res.docs[id]
+		if !ok {
+			http.Error(w, fmt.Sprintf("%s %q not found", res.name, id), http.StatusNotFound)
+			return
+		}
+		delete(res.docs, id)
+
+		d.generalDescriptor().State = ptr(DestroyedState)
+		d.setAcknowledge(&Acknowledge{Status: FulfilledAcknowledgeStatus})
+		writeDocument(w, http.StatusOK, res.mimeType, d)
+	}
+}
+
+func (s *Server) discoverFunctions(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseFunctionDiscoveryRequest(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fns := []Function{}
+	for _, fn := range s.functions {
+		if req.Matches(fn) {
+			fns = append(fns, *fn)
+		}
+	}
+	slices.SortFunc(fns, func(a, b Function) int { return strings.Compare(a.General.ID, b.General.ID) })
+	writeDocument(w, http.StatusOK, JSONMIMEType, fns)
+}
+
+// readDocument decodes and validates the description document of r. Errors are written to w.
+func readDocument(w http.ResponseWriter, r *http.Request, res *resource) (descriptionDocument, bool) {
+	if err := CheckContentType(r.Header, res.mimeType); err != nil {
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+		return nil, false
+	}
+	d := res.new()
+	if err := json.NewDecoder(r.Body).Decode(d); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	err := d.Validate(res.kind)
+	var fieldErrs FieldErrors
+	switch {
+	case err == nil:
+		return d, true
+	case errors.As(err, &fieldErrs):
+		ack := &Acknowledge{Status: FailedAcknowledgeStatus}
+		for _, fe := range fieldErrs {
+			ack.Failed = append(ack.Failed, fe.Path)
+		}
+		d.setAcknowledge(ack)
+		writeDocument(w, http.StatusBadRequest, res.mimeType, d)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+	return nil, false
+}
+
+func writeDocument(w http.ResponseWriter, status int, mimeType string, v any) {
+	w.Header().Set("Content-Type", mimeType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
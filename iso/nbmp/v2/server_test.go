@@ -0,0 +1,231 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(nbmp.NewServer(newGroupRepository(t, newABRGroup())))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func doWorkflow(t *testing.T, baseURL string, r *nbmp.WorkflowRequest) (*nbmp.WorkflowResponse, error) {
+	t.Helper()
+	req, err := r.HTTPRequest(context.Background(), baseURL)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %s", r.Operation, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %s", r.Operation, err)
+	}
+	return nbmp.ReadWorkflowResponse(resp)
+}
+
+func TestServerWorkflowAPI(t *testing.T) {
+	ts := newTestServer(t)
+
+	resp, err := doWorkflow(t, ts.URL, &nbmp.WorkflowRequest{
+		Operation: nbmp.CreateWorkflowOperation,
+		Workflow:  &nbmp.Workflow{General: nbmp.General{Name: "live"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateWorkflow: unexpected error: %s", err)
+	}
+	wf := resp.Workflow
+	if resp.StatusCode != http.StatusCreated || wf.General.ID == "" {
+		t.Fatalf("CreateWorkflow: unexpected response %d %v", resp.StatusCode, wf)
+	}
+	if wf.Acknowledge == nil || wf.Acknowledge.Status != nbmp.FulfilledAcknowledgeStatus {
+		t.Errorf("CreateWorkflow: unexpected acknowledge %v", wf.Acknowledge)
+	}
+	if wf.General.State == nil || *wf.General.State != nbmp.InstantiatedState {
+		t.Errorf("CreateWorkflow: unexpected state %v", wf.General.State)
+	}
+
+	wf.General.Description = "updated"
+	if _, err := doWorkflow(t, ts.URL, &nbmp.WorkflowRequest{Operation: nbmp.UpdateWorkflowOperation, Workflow: wf}); err != nil {
+		t.Fatalf("UpdateWorkflow: unexpected error: %s", err)
+	}
+
+	resp, err = doWorkflow(t, ts.URL, &nbmp.WorkflowRequest{Operation: nbmp.GetWorkflowOperation, ID: wf.General.ID})
+	if err != nil {
+		t.Fatalf("GetWorkflow: unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(wf, resp.Workflow); diff != "" {
+		t.Errorf("GetWorkflow: unexpected workflow (-want +got):\n%s", diff)
+	}
+
+	resp, err = doWorkflow(t, ts.URL, &nbmp.WorkflowRequest{Operation: nbmp.DeleteWorkflowOperation, ID: wf.General.ID})
+	if err != nil {
+		t.Fatalf("DeleteWorkflow: unexpected error: %s", err)
+	}
+	if s := resp.Workflow.General.State; s == nil || *s != nbmp.DestroyedState {
+		t.Errorf("DeleteWorkflow: unexpected state %v", s)
+	}
+
+	var apiErr *nbmp.APIError
+	_, err = doWorkflow(t, ts.URL, &nbmp.WorkflowRequest{Operation: nbmp.GetWorkflowOperation, ID: wf.General.ID})
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("GetWorkflow: expected 404 error, got %v", err)
+	}
+}
+
+func doTask(t *testing.T, baseURL string, r *nbmp.TaskRequest) (*nbmp.TaskResponse, error) {
+	t.Helper()
+	req, err := r.HTTPRequest(context.Background(), baseURL)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %s", r.Operation, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %s", r.Operation, err)
+	}
+	return nbmp.ReadTaskResponse(resp)
+}
+
+func TestServerTaskAPI(t *testing.T) {
+	ts := newTestServer(t)
+
+	task := &nbmp.Task{General: nbmp.General{ID: "transcode"}}
+	for _, r := range []*nbmp.TaskRequest{
+		{Operation: nbmp.CreateTaskOperation, Task: task},
+		{Operation: nbmp.UpdateTaskOperation, Task: task},
+		{Operation: nbmp.GetTaskOperation, ID: "transcode"},
+		{Operation: nbmp.DeleteTaskOperation, ID: "transcode"},
+	} {
+		resp, err := doTask(t, ts.URL, r)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", r.Operation, err)
+		}
+		if resp.Task == nil || resp.Task.General.ID != "transcode" {
+			t.Errorf("%s: unexpected task %v", r.Operation, resp.Task)
+		}
+	}
+
+	create := &nbmp.TaskRequest{Operation: nbmp.CreateTaskOperation, Task: task}
+	if _, err := doTask(t, ts.URL, create); err != nil {
+		t.Fatalf("CreateTask: unexpected error: %s", err)
+	}
+	var apiErr *nbmp.APIError
+	if _, err := doTask(t, ts.URL, create); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		t.Errorf("CreateTask: expected 409 error, got %v", err)
+	}
+}
+
+func TestServerRejectsInvalidDocuments(t *testing.T) {
+	ts := newTestServer(t)
+
+	resp, err := doWorkflow(t, ts.URL, &nbmp.WorkflowRequest{
+		Operation: nbmp.CreateWorkflowOperation,
+		Workflow:  &nbmp.Workflow{General: nbmp.General{IsGroup: ptr(true)}},
+	})
+	var apiErr *nbmp.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 error, got %v", err)
+	}
+	if resp.Workflow == nil {
+		t.Fatal("expected workflow in response")
+	}
+	want := &nbmp.Acknowledge{Status: nbmp.FailedAcknowledgeStatus, Failed: []string{"/general/is-group", "/processing/connection-map"}}
+	if diff := cmp.Diff(want, resp.Workflow.Acknowledge); diff != "" {
+		t.Errorf("unexpected acknowledge (-want +got):\n%s", diff)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, ts.URL+"/workflows", strings.NewReader("{}"))
+	req.Header.Set("Content-Type", nbmp.TaskDescriptionDocumentMIMEType)
+	httpResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := nbmp.ReadWorkflowResponse(httpResp); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("expected 415 error, got %v", err)
+	}
+}
+
+func TestServerFunctionDiscoveryAPI(t *testing.T) {
+	ts := newTestServer(t)
+
+	tests := []struct {
+		req  nbmp.FunctionDiscoveryRequest
+		want []string
+	}{
+		{nbmp.FunctionDiscoveryRequest{}, []string{abrFunctionID, packageFunctionID, transcodeFunctionID}},
+		{nbmp.FunctionDiscoveryRequest{Keywords: []string{"package", "dash"}}, []string{packageFunctionID}},
+		{nbmp.FunctionDiscoveryRequest{Name: "transcode"}, []string{transcodeFunctionID}},
+		{nbmp.FunctionDiscoveryRequest{ID: "missing"}, nil},
+	}
+
+	for _, tc := range tests {
+		req, err := tc.req.HTTPRequest(context.Background(), ts.URL)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", tc.req, err)
+		}
+		httpResp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", tc.req, err)
+		}
+		resp, err := nbmp.ReadFunctionDiscoveryResponse(httpResp)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", tc.req, err)
+		}
+		var ids []string
+		for _, fn := range resp.Functions {
+			ids = append(ids, fn.General.ID)
+		}
+		if diff := cmp.Diff(tc.want, ids); diff != "" {
+			t.Errorf("%v: unexpected functions (-want +got):\n%s", tc.req, diff)
+		}
+	}
+}
+
+func TestWorkflowRequestValidate(t *testing.T) {
+	wf := &nbmp.Workflow{General: nbmp.General{ID: "wf"}}
+	tests := []struct {
+		req   nbmp.WorkflowRequest
+		valid bool
+	}{
+		{nbmp.WorkflowRequest{Operation: nbmp.CreateWorkflowOperation, Workflow: wf}, true},
+		{nbmp.WorkflowRequest{Operation: nbmp.CreateWorkflowOperation}, false},
+		{nbmp.WorkflowRequest{Operation: nbmp.UpdateWorkflowOperation, Workflow: wf}, true},
+		{nbmp.WorkflowRequest{Operation: nbmp.UpdateWorkflowOperation, ID: "other", Workflow: wf}, false},
+		{nbmp.WorkflowRequest{Operation: nbmp.UpdateWorkflowOperation, Workflow: &nbmp.Workflow{}}, false},
+		{nbmp.WorkflowRequest{Operation: nbmp.GetWorkflowOperation, ID: "wf"}, true},
+		{nbmp.WorkflowRequest{Operation: nbmp.GetWorkflowOperation}, false},
+		{nbmp.WorkflowRequest{Operation: nbmp.DeleteWorkflowOperation, ID: "wf", Workflow: wf}, false},
+		{nbmp.WorkflowRequest{Operation: nbmp.GetTaskOperation, ID: "wf"}, false},
+	}
+
+	for _, tc := range tests {
+		if err := tc.req.Validate(); (err == nil) != tc.valid {
+			t.Errorf("%s: unexpected result %v", tc.req.Operation, err)
+		}
+	}
+}
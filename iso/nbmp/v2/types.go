@@ -19,6 +19,7 @@ package v2
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/nagare-media/models.go/base"
@@ -354,11 +355,13 @@ type PortBinding struct {
 }
 
 // Helper interface type when dealing with inputs or outputs.
+// +kubebuilder:object:generate=false
 type InputOrOutput interface {
 	GetMediaParameters() []MediaParameter
 	SetMediaParameters([]MediaParameter)
 	GetMetadataParameters() []MetadataParameter
 	SetMetadataParameters([]MetadataParameter)
+	DeepCopyInputOrOutput() InputOrOutput
 }
 
 // This descriptor provides the parameters of the underlying resource’s inputs. The input descriptor consists of two
@@ -389,6 +392,10 @@ func (i *Input) SetMetadataParameters(mp []MetadataParameter) {
 	i.MetadataParameters = mp
 }
 
+func (i *Input) DeepCopyInputOrOutput() InputOrOutput {
+	return i.DeepCopy()
+}
+
 // This descriptor provides the parameters of the underlying resource’s outputs. The output descriptor consists of two
 // arrays of objects: one for the media outputs and one for metadata outputs.
 type Output struct {
@@ -417,7 +424,12 @@ func (o *Output) SetMetadataParameters(mp []MetadataParameter) {
 	o.MetadataParameters = mp
 }
 
+func (o *Output) DeepCopyInputOrOutput() InputOrOutput {
+	return o.DeepCopy()
+}
+
 // Helper interface type when dealing with media or metadata parameters.
+// +kubebuilder:object:generate=false
 type MediaOrMetadataParameter interface {
 	GetStreamID() string
 	SetStreamID(string)
@@ -441,6 +453,7 @@ type MediaOrMetadataParameter interface {
 	SetCachingServerURL(*base.URI)
 	GetCompletionTimeout() *uint64
 	SetCompletionTimeout(*uint64)
+	DeepCopyMediaOrMetadataParameter() MediaOrMetadataParameter
 }
 
 type MediaParameter struct {
@@ -625,6 +638,10 @@ func (mp *MediaParameter) SetCompletionTimeout(t *uint64) {
 	mp.CompletionTimeout = t
 }
 
+func (mp *MediaParameter) DeepCopyMediaOrMetadataParameter() MediaOrMetadataParameter {
+	return mp.DeepCopy()
+}
+
 type MetadataParameter struct {
 	// unique identifier, with the scope of function or task or workflow, to identify the media or metadata stream
 	//
@@ -796,6 +813,10 @@ func (mp *MetadataParameter) SetCompletionTimeout(t *uint64) {
 	mp.CompletionTimeout = t
 }
 
+func (mp *MetadataParameter) DeepCopyMediaOrMetadataParameter() MediaOrMetadataParameter {
+	return mp.DeepCopy()
+}
+
 type MediaAccessMode string
 
 const (
@@ -871,14 +892,14 @@ type StaticImageInfo struct {
 	PatchURL *string `json:"patch-url,omitempty"`
 
 	// +optional
-	PatchScript map[string]interface{} `json:"patch-script,omitempty"`
+	PatchScript RawJSON `json:"patch-script,omitempty"`
 }
 
 type DynamicImageInfo struct {
 	// URL (according to IETF RFC 3986) defining information object scheme or information needed for dynamic build
 	Scheme base.URI `json:"scheme"`
 
-	Information map[string]interface{} `json:"information"`
+	Information RawJSON `json:"information"`
 }
 
 type ConnectionMapping struct {
@@ -1194,7 +1215,7 @@ type Parameter struct {
 	Exclusions []int64 `json:"exclusions,omitempty"`
 
 	// must be set for non-array datatypes
-	// must be nil for array datatype
+	// may be set for array datatype as extension to NBMP
 	// +optional
 	Values []ParameterValue `json:"values,omitempty"`
 
@@ -1202,21 +1223,39 @@ type Parameter struct {
 	// must be set for array datatype
 	// TODO: this is an array in the JSON schema, but must (likely) be an JSON schema object.
 	// +optional
-	Schema map[string]interface{} `json:"schema,omitempty"`
+	Schema RawJSON `json:"schema,omitempty"`
 }
 
-var _ json.Unmarshaler = &Parameter{}
+var (
+	_ json.Marshaler   = &Parameter{}
+	_ json.Unmarshaler = &Parameter{}
+)
+
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	// prevent recursion
+	type PlainParameter Parameter
+
+	for _, val := range p.Values {
+		if val == nil {
+			continue
+		}
+		if dt := valueDatatype(val); dt != p.Datatype {
+			return nil, fmt.Errorf("Parameter.MarshalJSON: value %d has datatype %q instead of %q", val.GetID(), dt, p.Datatype)
+		}
+	}
+	return json.Marshal(PlainParameter(p))
+}
 
 func (p *Parameter) UnmarshalJSON(data []byte) error {
 	type PartialParameter struct {
-		Name        string                 `json:"name"`
-		ID          int64                  `json:"id"`
-		Description *string                `json:"discription,omitempty"` // TODO: spelling
-		Datatype    Datatype               `json:"datatype"`
-		Conditions  []int64                `json:"conditions,omitempty"`
-		Exclusions  []int64                `json:"exclusions,omitempty"`
-		Values      []json.RawMessage      `json:"values,omitempty"`
-		Schema      map[string]interface{} `json:"schema,omitempty"`
+		Name        string            `json:"name"`
+		ID          int64             `json:"id"`
+		Description *string           `json:"discription,omitempty"` // TODO: spelling
+		Datatype    Datatype          `json:"datatype"`
+		Conditions  []int64           `json:"conditions,omitempty"`
+		Exclusions  []int64           `json:"exclusions,omitempty"`
+		Values      []json.RawMessage `json:"values,omitempty"`
+		Schema      RawJSON           `json:"schema,omitempty"`
 	}
 
 	pp := PartialParameter{}
@@ -1246,7 +1285,7 @@ func (p *Parameter) UnmarshalJSON(data []byte) error {
 		case StringDatatype:
 			val = &StringParameterValue{}
 		case ArrayDatatype:
-			return errors.New("Parameter.UnmarshalJSON: specifying values is invalid for array datatype")
+			val = &ArrayParameterValue{}
 		default:
 			return errors.New("Parameter.UnmarshalJSON: values datatype unspecified or unknown")
 		}
@@ -1271,11 +1310,13 @@ const (
 	ArrayDatatype   = Datatype("array")
 )
 
+// +kubebuilder:object:generate=false
 type ParameterValue interface {
 	GetName() string
 	SetName(string)
 	GetID() int64
 	SetID(int64)
+	DeepCopyParameterValue() ParameterValue
 }
 
 type BooleanParameterValue struct {
@@ -1304,6 +1345,10 @@ func (pv *BooleanParameterValue) SetID(id int64) {
 	pv.ID = id
 }
 
+func (pv *BooleanParameterValue) DeepCopyParameterValue() ParameterValue {
+	return pv.DeepCopy()
+}
+
 type IntegerParameterValue struct {
 	Name string `json:"name"`
 
@@ -1330,6 +1375,10 @@ func (pv *IntegerParameterValue) SetID(id int64) {
 	pv.ID = id
 }
 
+func (pv *IntegerParameterValue) DeepCopyParameterValue() ParameterValue {
+	return pv.DeepCopy()
+}
+
 type IntegerParameterValueRestrictions struct {
 	// +optional
 	MinValue *int64 `json:"min-value,omitempty"`
@@ -1367,6 +1416,10 @@ func (pv *NumberParameterValue) SetID(id int64) {
 	pv.ID = id
 }
 
+func (pv *NumberParameterValue) DeepCopyParameterValue() ParameterValue {
+	return pv.DeepCopy()
+}
+
 type NumberParameterValueRestrictions struct {
 	// +optional
 	MinValue *float64 `json:"min-value,omitempty"`
@@ -1404,6 +1457,60 @@ func (pv *StringParameterValue) SetID(id int64) {
 	pv.ID = id
 }
 
+func (pv *StringParameterValue) DeepCopyParameterValue() ParameterValue {
+	return pv.DeepCopy()
+}
+
+// ArrayParameterValue is a value of a parameter with array datatype. NBMP only describes array parameters by their
+// schema; this extension allows to define value IDs for arrays that can be referenced by conditions and exclusions.
+type ArrayParameterValue struct {
+	Name string `json:"name"`
+
+	ID int64 `json:"id"`
+
+	// JSON schema the array has to match
+	Restrictions RawJSON `json:"restrictions"`
+}
+
+var _ ParameterValue = &ArrayParameterValue{}
+
+func (pv *ArrayParameterValue) GetName() string {
+	return pv.Name
+}
+
+func (pv *ArrayParameterValue) SetName(n string) {
+	pv.Name = n
+}
+
+func (pv *ArrayParameterValue) GetID() int64 {
+	return pv.ID
+}
+
+func (pv *ArrayParameterValue) SetID(id int64) {
+	pv.ID = id
+}
+
+func (pv *ArrayParameterValue) DeepCopyParameterValue() ParameterValue {
+	return pv.DeepCopy()
+}
+
+// valueDatatype returns the datatype of the parameter value type.
+func valueDatatype(val ParameterValue) Datatype {
+	switch val.(type) {
+	case *BooleanParameterValue:
+		return BooleanDatatype
+	case *IntegerParameterValue:
+		return IntegerDatatype
+	case *NumberParameterValue:
+		return NumberDatatype
+	case *StringParameterValue:
+		return StringDatatype
+	case *ArrayParameterValue:
+		return ArrayDatatype
+	}
+	return ""
+}
+
 // This descriptor provides information for a delayed startup of the underlying resource.
 type StartupDelay struct {
 	// amount of delay before task startup in seconds
@@ -1444,7 +1551,7 @@ type ClientAssistant struct {
 	// shall not be present for function description documents
 	//
 	// +optional
-	MeasurementCollectionList map[string]interface{} `json:"measurement-collection-list,omitempty"`
+	MeasurementCollectionList RawJSON `json:"measurement-collection-list,omitempty"`
 
 	// list of objects where each object represents different type information from NBMP source
 	//
@@ -1453,7 +1560,7 @@ type ClientAssistant struct {
 	// shall not be present for function description documents
 	//
 	// +optional
-	SourceAssistanceInformation map[string]interface{} `json:"source-assistance-information,omitempty"`
+	SourceAssistanceInformation RawJSON `json:"source-assistance-information,omitempty"`
 }
 
 type Failover struct {
@@ -1575,10 +1682,10 @@ type Monitoring struct {
 	Variable []Variable `json:"variable,omitempty"`
 
 	// +optional
-	SystemEvents []map[string]interface{} `json:"system-events,omitempty"`
+	SystemEvents []RawJSON `json:"system-events,omitempty"`
 
 	// +optional
-	SystemVariables []map[string]interface{} `json:"system-variables,omitempty"`
+	SystemVariables []RawJSON `json:"system-variables,omitempty"`
 }
 
 // This descriptor provides reporting information for the underlying resource.
@@ -1590,10 +1697,10 @@ type Reporting struct {
 	Variable []Variable `json:"variable,omitempty"`
 
 	// +optional
-	SystemEvents []map[string]interface{} `json:"system-events,omitempty"`
+	SystemEvents []RawJSON `json:"system-events,omitempty"`
 
 	// +optional
-	SystemVariables []map[string]interface{} `json:"system-variables,omitempty"`
+	SystemVariables []RawJSON `json:"system-variables,omitempty"`
 
 	// describes the type of report, defined by the NBMP source or workflow manager
 	ReportType string `json:"report-type"`
@@ -1627,10 +1734,10 @@ type Notification struct {
 	Variable []Variable `json:"variable,omitempty"`
 
 	// +optional
-	SystemEvents []map[string]interface{} `json:"system-events,omitempty"`
+	SystemEvents []RawJSON `json:"system-events,omitempty"`
 
 	// +optional
-	SystemVariables []map[string]interface{} `json:"system-variables,omitempty"`
+	SystemVariables []RawJSON `json:"system-variables,omitempty"`
 
 	// time for notification
 	NotificationTime time.Time `json:"notification-time"`
@@ -1704,7 +1811,7 @@ type AssertionValuePredicate struct {
 	EvaluationCondition AssertionEvaluationCondition `json:"evaluation-condition"`
 
 	// value against which the parameter value will be checked
-	CheckValue map[string]interface{} `json:"check-value"`
+	CheckValue RawJSON `json:"check-value"`
 
 	// aggregation function to be used while evaluating assertion across all tasks in the workflow
 	Aggregation AssertionValuePredicateAggregation `json:"aggregation"`
@@ -0,0 +1,355 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DocumentKind is the kind of an NBMP description document. Some rules of NBMP depend on the kind of document a
+// descriptor is part of.
+type DocumentKind string
+
+const (
+	WorkflowDescriptionDocumentKind = DocumentKind(WorkflowDescriptionDocumentExt)
+	TaskDescriptionDocumentKind     = DocumentKind(TaskDescriptionDocumentExt)
+	FunctionDescriptionDocumentKind = DocumentKind(FunctionDescriptionDocumentExt)
+)
+
+var placementPattern = regexp.MustCompile(`(^[A-Z]{2}$)|(^[A-Z]{2}-.*)`)
+
+// FieldError is a violation of a semantic rule of NBMP.
+// +kubebuilder:object:generate=false
+type FieldError struct {
+	// JSON pointer to the violating field in the document, e.g. "/processing/connection-map/0/from/port-name".
+	Path string
+
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// FieldErrors lists all violations of a document.
+// +kubebuilder:object:generate=false
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "invalid fields: " + strings.Join(msgs, "; ")
+}
+
+// ValidateOption configures Validate.
+// +kubebuilder:object:generate=false
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	ctx  context.Context
+	repo FunctionRepository
+}
+
+// WithFunctionRepository checks the port names of connection-map entries against the ports of the referenced functions
+// in repo unless the function restriction of the instance describes its ports. Functions are looked up with ctx.
+// Functions missing in repo are reported as violations; other errors of repo are returned as is.
+func WithFunctionRepository(ctx context.Context, repo FunctionRepository) ValidateOption {
+	return func(o *validateOptions) {
+		o.ctx = ctx
+		o.repo = repo
+	}
+}
+
+// Validate checks the semantic rules of NBMP that are not expressed by the JSON schema for w as part of a document of
+// the given kind. It returns FieldErrors listing all violations. See ValidateWorkflow for validating the JSON schema.
+//
+// Port names of connection-map entries are checked against the ports of the function restriction of the referenced
+// instance if it describes them and otherwise against the ports of the referenced function (see
+// WithFunctionRepository).
+func (w *Workflow) Validate(kind DocumentKind, opts ...ValidateOption) error {
+	v, err := newFieldValidator("Workflow.Validate", kind, opts)
+	if err != nil {
+		return err
+	}
+	v.general("/general", &w.General)
+	v.input("/input", &w.Input)
+	v.output("/output", &w.Output)
+	v.processing("/processing", &w.Processing)
+	v.isGroup(&w.General, &w.Processing)
+	v.requirement("/requirement", &w.Requirement)
+	return v.err()
+}
+
+// Validate checks the semantic rules of NBMP that are not expressed by the JSON schema for t as part of a document of
+// the given kind. It returns FieldErrors listing all violations. See ValidateTask for validating the JSON schema.
+func (t *Task) Validate(kind DocumentKind, opts ...ValidateOption) error {
+	v, err := newFieldValidator("Task.Validate", kind, opts)
+	if err != nil {
+		return err
+	}
+	v.general("/general", &t.General)
+	v.input("/input", &t.Input)
+	v.output("/output", &t.Output)
+	v.processing("/processing", &t.Processing)
+	v.isGroup(&t.General, &t.Processing)
+	v.requirement("/requirement", &t.Requirement)
+	return v.err()
+}
+
+// Validate checks the semantic rules of NBMP that are not expressed by the JSON schema for f as part of a document of
+// the given kind. It returns FieldErrors listing all violations. See ValidateFunction for validating the JSON schema.
+// Ports of function groups referencing the group itself are not checked against the repository.
+func (f *Function) Validate(kind DocumentKind, opts ...ValidateOption) error {
+	v, err := newFieldValidator("Function.Validate", kind, opts)
+	if err != nil {
+		return err
+	}
+	v.self = f.General.ID
+	v.general("/general", &f.General)
+	v.input("/input", &f.Input)
+	v.output("/output", &f.Output)
+	if f.Processing != nil {
+		v.processing("/processing", f.Processing)
+	}
+	v.isGroup(&f.General, f.Processing)
+	if f.Requirement != nil {
+		v.requirement("/requirement", f.Requirement)
+	}
+	return v.err()
+}
+
+type fieldValidator struct {
+	fn   string
+	kind DocumentKind
+	opts validateOptions
+	errs FieldErrors
+
+	// self is the ID of the validated function whose group ports are referenced by its own connection map.
+	self string
+	// functions caches the functions looked up in the repository by ID. Missing functions are cached as nil.
+	functions map[string]*Function
+	// repoErr is the first error of the repository other than ErrFunctionNotFound.
+	repoErr error
+}
+
+func newFieldValidator(fn string, kind DocumentKind, opts []ValidateOption) (*fieldValidator, error) {
+	switch kind {
+	case WorkflowDescriptionDocumentKind, TaskDescriptionDocumentKind, FunctionDescriptionDocumentKind:
+		v := &fieldValidator{fn: fn, kind: kind, functions: make(map[string]*Function)}
+		for _, opt := range opts {
+			opt(&v.opts)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("%s: unknown document kind %q", fn, kind)
+}
+
+func (v *fieldValidator) errorf(path, format string, args ...any) {
+	v.errs = append(v.errs, &FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *fieldValidator) err() error {
+	if v.repoErr != nil {
+		return fmt.Errorf("%s: %w", v.fn, v.repoErr)
+	}
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *fieldValidator) general(path string, g *General) {
+	if g.Priority != nil && v.kind == FunctionDescriptionDocumentKind {
+		v.errorf(path+"/priority", "must not be present in function description documents")
+	}
+	if g.IsGroup != nil && v.kind == WorkflowDescriptionDocumentKind {
+		v.errorf(path+"/is-group", "must not be present in workflow description documents")
+	}
+
+	names := make(map[string]bool)
+	for _, ports := range []struct {
+		name  string
+		ports []Port
+	}{
+		{"input-ports", g.InputPorts},
+		{"output-ports", g.OutputPorts},
+	} {
+		for i, p := range ports.ports {
+			if names[p.PortName] {
+				v.errorf(index(path+"/"+ports.name, i)+"/port-name", "duplicate port name %q", p.PortName)
+			}
+			names[p.PortName] = true
+		}
+	}
+}
+
+// isGroup checks that groups have a connection map.
+func (v *fieldValidator) isGroup(g *General, p *Processing) {
+	if g.IsGroup != nil && *g.IsGroup && (p == nil || len(p.ConnectionMap) == 0) {
+		v.errorf("/processing/connection-map", "must be present if general.is-group is true")
+	}
+}
+
+func (v *fieldValidator) input(path string, in InputOrOutput) {
+	v.inputOrOutput(path, in, false)
+}
+
+func (v *fieldValidator) output(path string, out InputOrOutput) {
+	v.inputOrOutput(path, out, true)
+}
+
+func (v *fieldValidator) inputOrOutput(path string, io InputOrOutput, isOutput bool) {
+	check := func(path string, p MediaOrMetadataParameter) {
+		if t := p.GetTimeout(); t != nil && *t < 1 {
+			v.errorf(path+"/timeout", "must be at least 1")
+		}
+		if p.GetCompletionTimeout() != nil && isOutput {
+			v.errorf(path+"/completion-timeout", "must not be present for outputs")
+		}
+	}
+
+	mps := io.GetMediaParameters()
+	for i := range mps {
+		check(index(path+"/media-parameters", i), &mps[i])
+	}
+	mdps := io.GetMetadataParameters()
+	for i := range mdps {
+		check(index(path+"/metadata-parameters", i), &mdps[i])
+	}
+}
+
+func (v *fieldValidator) processing(path string, p *Processing) {
+	if p.StartTime != nil && v.kind == FunctionDescriptionDocumentKind {
+		v.errorf(path+"/start-time", "must not be present in function description documents")
+	}
+	if v.kind == TaskDescriptionDocumentKind {
+		if len(p.ConnectionMap) > 0 {
+			v.errorf(path+"/connection-map", "must not be present in task description documents")
+		}
+		if len(p.FunctionRestrictions) > 0 {
+			v.errorf(path+"/function-restrictions", "must not be present in task description documents")
+		}
+	}
+
+	restrictions := make(map[string]*FunctionRestriction, len(p.FunctionRestrictions))
+	for i := range p.FunctionRestrictions {
+		fr := &p.FunctionRestrictions[i]
+		frPath := index(path+"/function-restrictions", i)
+		if _, ok := restrictions[fr.Instance]; ok {
+			v.errorf(frPath+"/instance", "duplicate instance %q", fr.Instance)
+		}
+		restrictions[fr.Instance] = fr
+
+		if fr.Requirements != nil {
+			v.requirement(frPath+"/requirements", fr.Requirements)
+		}
+	}
+
+	ids := make(map[string]bool, len(p.ConnectionMap))
+	for i, cm := range p.ConnectionMap {
+		cmPath := index(path+"/connection-map", i)
+		if ids[cm.ConnectionID] {
+			v.errorf(cmPath+"/connection-id", "duplicate connection id %q", cm.ConnectionID)
+		}
+		ids[cm.ConnectionID] = true
+
+		if cm.From.InputRestrictions != nil {
+			v.errorf(cmPath+"/from/input-restrictions", "must not be present in from objects")
+		}
+		if cm.To.OutputRestrictions != nil {
+			v.errorf(cmPath+"/to/output-restrictions", "must not be present in to objects")
+		}
+
+		v.port(cmPath+"/from", cm.From, restrictions[cm.From.Instance], true)
+		v.port(cmPath+"/to", cm.To, restrictions[cm.To.Instance], false)
+	}
+}
+
+// port checks that the port name of a connection-map port is an output (from) or input (to) port of the function
+// restriction fr of its instance or, if fr does not describe these ports, of the referenced function.
+func (v *fieldValidator) port(path string, port ConnectionMappingPort, fr *FunctionRestriction, isOutput bool) {
+	kind, ports := "input", func(g *General) []Port { return g.InputPorts }
+	if isOutput {
+		kind, ports = "output", func(g *General) []Port { return g.OutputPorts }
+	}
+
+	var declared []Port
+	if fr != nil && fr.General != nil {
+		declared = ports(fr.General)
+	}
+	if len(declared) == 0 {
+		fn := v.function(path+"/id", port.ID)
+		if fn == nil {
+			return
+		}
+		declared = ports(&fn.General)
+	}
+	if !hasPort(declared, port.PortName) {
+		v.errorf(path+"/port-name", "unknown %s port %q of instance %q", kind, port.PortName, port.Instance)
+	}
+}
+
+// function returns the function id from the repository. It returns nil if no repository is configured, id references
+// the validated function itself or the function cannot be looked up.
+func (v *fieldValidator) function(path, id string) *Function {
+	if v.opts.repo == nil || v.repoErr != nil || (v.self != "" && id == v.self) {
+		return nil
+	}
+	fn, ok := v.functions[id]
+	if !ok {
+		var err error
+		fn, err = v.opts.repo.Function(v.opts.ctx, id)
+		if err != nil {
+			if !errors.Is(err, ErrFunctionNotFound) {
+				v.repoErr = err
+				return nil
+			}
+			fn = nil
+		}
+		v.functions[id] = fn
+	}
+	if fn == nil {
+		v.errorf(path, "unknown function %q", id)
+	}
+	return fn
+}
+
+func hasPort(ports []Port, name string) bool {
+	for _, p := range ports {
+		if p.PortName == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *fieldValidator) requirement(path string, r *Requirement) {
+	if r.Hardware != nil && r.Hardware.Placement != nil && !placementPattern.MatchString(string(*r.Hardware.Placement)) {
+		v.errorf(path+"/hardware/placement", "%q does not match pattern %q", *r.Hardware.Placement, placementPattern)
+	}
+}
+
+func index(path string, i int) string {
+	return path + "/" + strconv.Itoa(i)
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	nbmp "github.com/nagare-media/models.go/iso/nbmp/v2"
+)
+
+func TestValidateWorkflowFiles(t *testing.T) {
+	files := []string{
+		"testdata/nagare/v2_nbmp_live.wdd",
+		"testdata/nagare/v2_nbmp_vod.wdd",
+	}
+
+	for _, file := range files {
+		str, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("could not read file %s: %s", file, err)
+		}
+
+		wd := nbmp.Workflow{}
+		if err := json.NewDecoder(bytes.NewReader(str)).Decode(&wd); err != nil {
+			t.Fatalf("could not unmarshal workflow: %s", err)
+		}
+		if err := wd.Validate(nbmp.WorkflowDescriptionDocumentKind); err != nil {
+			t.Errorf("%s: unexpected error: %s", file, err)
+		}
+	}
+}
+
+func TestValidateFieldErrors(t *testing.T) {
+	placement := nbmp.HardwareRequirementPlacement("de-12345")
+
+	wf := &nbmp.Workflow{
+		General: nbmp.General{
+			ID:      "wf",
+			IsGroup: ptr(true),
+		},
+		Input: nbmp.Input{
+			MediaParameters: []nbmp.MediaParameter{{StreamID: "in", Timeout: ptr[uint64](0)}},
+		},
+		Output: nbmp.Output{
+			MetadataParameters: []nbmp.MetadataParameter{{StreamID: "out", CompletionTimeout: ptr[uint64](10)}},
+		},
+		Processing: nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				{
+					ConnectionID: "a-b",
+					From:         nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "out"},
+					To:           nbmp.ConnectionMappingPort{ID: "b", Instance: "b", PortName: "in"},
+				},
+				{
+					ConnectionID: "a-b",
+					From: nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "missing",
+						InputRestrictions: &nbmp.Input{}},
+					To: nbmp.ConnectionMappingPort{ID: "b", Instance: "b", PortName: "missing"},
+				},
+			},
+			FunctionRestrictions: []nbmp.FunctionRestriction{
+				{
+					Instance: "a",
+					General: &nbmp.General{
+						OutputPorts: []nbmp.Port{{PortName: "out"}},
+					},
+				},
+				{
+					Instance:     "b",
+					Requirements: &nbmp.Requirement{Hardware: &nbmp.HardwareRequirement{Placement: &placement}},
+				},
+			},
+		},
+	}
+
+	want := nbmp.FieldErrors{
+		{Path: "/general/is-group", Message: "must not be present in workflow description documents"},
+		{Path: "/input/media-parameters/0/timeout", Message: "must be at least 1"},
+		{Path: "/output/metadata-parameters/0/completion-timeout", Message: "must not be present for outputs"},
+		{Path: "/processing/function-restrictions/1/requirements/hardware/placement",
+			Message: `"de-12345" does not match pattern "(^[A-Z]{2}$)|(^[A-Z]{2}-.*)"`},
+		{Path: "/processing/connection-map/1/connection-id", Message: `duplicate connection id "a-b"`},
+		{Path: "/processing/connection-map/1/from/input-restrictions", Message: "must not be present in from objects"},
+		{Path: "/processing/connection-map/1/from/port-name", Message: `unknown output port "missing" of instance "a"`},
+	}
+	checkFieldErrors(t, "workflow", wf.Validate(nbmp.WorkflowDescriptionDocumentKind), want)
+
+	fn := &nbmp.Function{
+		General: nbmp.General{
+			ID:          "fn",
+			Priority:    ptr[uint64](1),
+			IsGroup:     ptr(true),
+			InputPorts:  []nbmp.Port{{PortName: "port"}},
+			OutputPorts: []nbmp.Port{{PortName: "port"}},
+		},
+	}
+	want = nbmp.FieldErrors{
+		{Path: "/general/priority", Message: "must not be present in function description documents"},
+		{Path: "/general/output-ports/0/port-name", Message: `duplicate port name "port"`},
+		{Path: "/processing/connection-map", Message: "must be present if general.is-group is true"},
+	}
+	checkFieldErrors(t, "function", fn.Validate(nbmp.FunctionDescriptionDocumentKind), want)
+
+	task := &nbmp.Task{
+		General: nbmp.General{ID: "task", Priority: ptr[uint64](1)},
+		Processing: nbmp.Processing{
+			ConnectionMap: wf.Processing.ConnectionMap[:1],
+		},
+	}
+	want = nbmp.FieldErrors{
+		{Path: "/processing/connection-map", Message: "must not be present in task description documents"},
+	}
+	checkFieldErrors(t, "task", task.Validate(nbmp.TaskDescriptionDocumentKind), want)
+}
+
+func TestValidateFunctionRepository(t *testing.T) {
+	repo := nbmp.MemoryFunctionRepository{
+		"a": {General: nbmp.General{ID: "a", OutputPorts: []nbmp.Port{{PortName: "out"}}}},
+		"b": {General: nbmp.General{ID: "b", InputPorts: []nbmp.Port{{PortName: "in"}}}},
+	}
+	wf := &nbmp.Workflow{
+		General: nbmp.General{ID: "wf"},
+		Processing: nbmp.Processing{
+			ConnectionMap: []nbmp.ConnectionMapping{
+				{
+					ConnectionID: "valid",
+					From:         nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "out"},
+					To:           nbmp.ConnectionMappingPort{ID: "b", Instance: "b", PortName: "in"},
+				},
+				{
+					ConnectionID: "unknown-from",
+					From:         nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "in"},
+					To:           nbmp.ConnectionMappingPort{ID: "b", Instance: "b", PortName: "in"},
+				},
+				{
+					ConnectionID: "unknown-to",
+					From:         nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "out"},
+					To:           nbmp.ConnectionMappingPort{ID: "b", Instance: "b", PortName: "out"},
+				},
+				{
+					ConnectionID: "unknown-function",
+					From:         nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "out"},
+					To:           nbmp.ConnectionMappingPort{ID: "c", Instance: "c", PortName: "in"},
+				},
+				{
+					ConnectionID: "restricted",
+					From:         nbmp.ConnectionMappingPort{ID: "a", Instance: "a", PortName: "out"},
+					To:           nbmp.ConnectionMappingPort{ID: "b", Instance: "b2", PortName: "extra"},
+				},
+			},
+			FunctionRestrictions: []nbmp.FunctionRestriction{
+				{
+					Instance: "b2",
+					General:  &nbmp.General{InputPorts: []nbmp.Port{{PortName: "extra"}}},
+				},
+			},
+		},
+	}
+
+	if err := wf.Validate(nbmp.WorkflowDescriptionDocumentKind); err != nil {
+		t.Errorf("unexpected error without repository: %s", err)
+	}
+
+	want := nbmp.FieldErrors{
+		{Path: "/processing/connection-map/1/from/port-name", Message: `unknown output port "in" of instance "a"`},
+		{Path: "/processing/connection-map/2/to/port-name", Message: `unknown input port "out" of instance "b"`},
+		{Path: "/processing/connection-map/3/to/id", Message: `unknown function "c"`},
+	}
+	err := wf.Validate(nbmp.WorkflowDescriptionDocumentKind, nbmp.WithFunctionRepository(context.Background(), repo))
+	checkFieldErrors(t, "workflow", err, want)
+
+	errRepo := errors.New("repository failed")
+	err = wf.Validate(nbmp.WorkflowDescriptionDocumentKind,
+		nbmp.WithFunctionRepository(context.Background(), failingRepository{errRepo}))
+	if !errors.Is(err, errRepo) {
+		t.Errorf("expected repository error, got %v", err)
+	}
+}
+
+type failingRepository struct {
+	err error
+}
+
+func (r failingRepository) Function(ctx context.Context, id string) (*nbmp.Function, error) {
+	return nil, r.err
+}
+
+func checkFieldErrors(t *testing.T, name string, err error, want nbmp.FieldErrors) {
+	t.Helper()
+	var got nbmp.FieldErrors
+	if !errors.As(err, &got) {
+		t.Errorf("%s: expected FieldErrors, got %v", name, err)
+		return
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("%s: unexpected errors (-want +got):\n%s", name, diff)
+	}
+}
+
+func TestValidateUnknownKind(t *testing.T) {
+	wf := &nbmp.Workflow{}
+	err := wf.Validate(nbmp.DocumentKind("mpe"))
+	var fieldErrs nbmp.FieldErrors
+	if err == nil || errors.As(err, &fieldErrs) {
+		t.Errorf("expected unknown kind error, got %v", err)
+	}
+}
@@ -0,0 +1,2281 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"github.com/nagare-media/models.go/base"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Acknowledge) DeepCopyInto(out *Acknowledge) {
+	*out = *in
+	if in.Unsupported != nil {
+		in, out := &in.Unsupported, &out.Unsupported
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Partial != nil {
+		in, out := &in.Partial, &out.Partial
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Acknowledge.
+func (in *Acknowledge) DeepCopy() *Acknowledge {
+	if in == nil {
+		return nil
+	}
+	out := new(Acknowledge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArrayParameterValue) DeepCopyInto(out *ArrayParameterValue) {
+	*out = *in
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrayParameterValue.
+func (in *ArrayParameterValue) DeepCopy() *ArrayParameterValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ArrayParameterValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Assertion) DeepCopyInto(out *Assertion) {
+	*out = *in
+	if in.SupportVerification != nil {
+		in, out := &in.SupportVerification, &out.SupportVerification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VerificationAcknowledgement != nil {
+		in, out := &in.VerificationAcknowledgement, &out.VerificationAcknowledgement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Assertion != nil {
+		in, out := &in.Assertion, &out.Assertion
+		*out = make([]AssertionItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Assertion.
+func (in *Assertion) DeepCopy() *Assertion {
+	if in == nil {
+		return nil
+	}
+	out := new(Assertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssertionItem) DeepCopyInto(out *AssertionItem) {
+	*out = *in
+	in.ValuePredicate.DeepCopyInto(&out.ValuePredicate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssertionItem.
+func (in *AssertionItem) DeepCopy() *AssertionItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AssertionItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssertionValuePredicate) DeepCopyInto(out *AssertionValuePredicate) {
+	*out = *in
+	if in.CheckValue != nil {
+		in, out := &in.CheckValue, &out.CheckValue
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+	if in.Offset != nil {
+		in, out := &in.Offset, &out.Offset
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionParameters != nil {
+		in, out := &in.ActionParameters, &out.ActionParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssertionValuePredicate.
+func (in *AssertionValuePredicate) DeepCopy() *AssertionValuePredicate {
+	if in == nil {
+		return nil
+	}
+	out := new(AssertionValuePredicate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BooleanParameterValue) DeepCopyInto(out *BooleanParameterValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BooleanParameterValue.
+func (in *BooleanParameterValue) DeepCopy() *BooleanParameterValue {
+	if in == nil {
+		return nil
+	}
+	out := new(BooleanParameterValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Capabilities) DeepCopyInto(out *Capabilities) {
+	*out = *in
+	if in.ResourceAvailability != nil {
+		in, out := &in.ResourceAvailability, &out.ResourceAvailability
+		*out = make([]ResourceAvailabilityItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(HardwareRequirementPlacement)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Repository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Functions != nil {
+		in, out := &in.Functions, &out.Functions
+		*out = make([]Function, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Connectivity != nil {
+		in, out := &in.Connectivity, &out.Connectivity
+		*out = make([]CapabilityConnectivity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PersistencyCapabilities != nil {
+		in, out := &in.PersistencyCapabilities, &out.PersistencyCapabilities
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurePersistency != nil {
+		in, out := &in.SecurePersistency, &out.SecurePersistency
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PersistenceStorageURL != nil {
+		in, out := &in.PersistenceStorageURL, &out.PersistenceStorageURL
+		*out = make([]base.URI, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Capabilities.
+func (in *Capabilities) DeepCopy() *Capabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(Capabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapabilityConnectivity) DeepCopyInto(out *CapabilityConnectivity) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(base.URI)
+		**out = **in
+	}
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
+		*out = new(CapabilityConnectivityProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Return != nil {
+		in, out := &in.Return, &out.Return
+		*out = new(CapabilityConnectivityProperties)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapabilityConnectivity.
+func (in *CapabilityConnectivity) DeepCopy() *CapabilityConnectivity {
+	if in == nil {
+		return nil
+	}
+	out := new(CapabilityConnectivity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapabilityConnectivityProperties) DeepCopyInto(out *CapabilityConnectivityProperties) {
+	*out = *in
+	if in.MinDelay != nil {
+		in, out := &in.MinDelay, &out.MinDelay
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxThroughput != nil {
+		in, out := &in.MaxThroughput, &out.MaxThroughput
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.AveragingWindow != nil {
+		in, out := &in.AveragingWindow, &out.AveragingWindow
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapabilityConnectivityProperties.
+func (in *CapabilityConnectivityProperties) DeepCopy() *CapabilityConnectivityProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(CapabilityConnectivityProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientAssistant) DeepCopyInto(out *ClientAssistant) {
+	*out = *in
+	if in.MeasurementCollectionList != nil {
+		in, out := &in.MeasurementCollectionList, &out.MeasurementCollectionList
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceAssistanceInformation != nil {
+		in, out := &in.SourceAssistanceInformation, &out.SourceAssistanceInformation
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientAssistant.
+func (in *ClientAssistant) DeepCopy() *ClientAssistant {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientAssistant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Configuration) DeepCopyInto(out *Configuration) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
+func (in *Configuration) DeepCopy() *Configuration {
+	if in == nil {
+		return nil
+	}
+	out := new(Configuration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionMapping) DeepCopyInto(out *ConnectionMapping) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+	in.To.DeepCopyInto(&out.To)
+	if in.Flowcontrol != nil {
+		in, out := &in.Flowcontrol, &out.Flowcontrol
+		*out = new(FlowcontrolRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CoLocated != nil {
+		in, out := &in.CoLocated, &out.CoLocated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Breakable != nil {
+		in, out := &in.Breakable, &out.Breakable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OtherParameters != nil {
+		in, out := &in.OtherParameters, &out.OtherParameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionMapping.
+func (in *ConnectionMapping) DeepCopy() *ConnectionMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionMappingPort) DeepCopyInto(out *ConnectionMappingPort) {
+	*out = *in
+	if in.InputRestrictions != nil {
+		in, out := &in.InputRestrictions, &out.InputRestrictions
+		*out = new(Input)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutputRestrictions != nil {
+		in, out := &in.OutputRestrictions, &out.OutputRestrictions
+		*out = new(Output)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionMappingPort.
+func (in *ConnectionMappingPort) DeepCopy() *ConnectionMappingPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionMappingPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultValue) DeepCopyInto(out *DefaultValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultValue.
+func (in *DefaultValue) DeepCopy() *DefaultValue {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicImageInfo) DeepCopyInto(out *DynamicImageInfo) {
+	*out = *in
+	if in.Information != nil {
+		in, out := &in.Information, &out.Information
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicImageInfo.
+func (in *DynamicImageInfo) DeepCopy() *DynamicImageInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicImageInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Event) DeepCopyInto(out *Event) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Definition != nil {
+		in, out := &in.Definition, &out.Definition
+		*out = new(string)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(base.URI)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Event.
+func (in *Event) DeepCopy() *Event {
+	if in == nil {
+		return nil
+	}
+	out := new(Event)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Failover) DeepCopyInto(out *Failover) {
+	*out = *in
+	if in.BackupDeploymentURL != nil {
+		in, out := &in.BackupDeploymentURL, &out.BackupDeploymentURL
+		*out = new(base.URI)
+		**out = **in
+	}
+	if in.PersistenceURL != nil {
+		in, out := &in.PersistenceURL, &out.PersistenceURL
+		*out = new(base.URI)
+		**out = **in
+	}
+	if in.PersistenceInterval != nil {
+		in, out := &in.PersistenceInterval, &out.PersistenceInterval
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Failover.
+func (in *Failover) DeepCopy() *Failover {
+	if in == nil {
+		return nil
+	}
+	out := new(Failover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowcontrolRequirement) DeepCopyInto(out *FlowcontrolRequirement) {
+	*out = *in
+	if in.TypicalDelay != nil {
+		in, out := &in.TypicalDelay, &out.TypicalDelay
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MinDelay != nil {
+		in, out := &in.MinDelay, &out.MinDelay
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MinThroughput != nil {
+		in, out := &in.MinThroughput, &out.MinThroughput
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxThroughput != nil {
+		in, out := &in.MaxThroughput, &out.MaxThroughput
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.AveragingWindow != nil {
+		in, out := &in.AveragingWindow, &out.AveragingWindow
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowcontrolRequirement.
+func (in *FlowcontrolRequirement) DeepCopy() *FlowcontrolRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowcontrolRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Function) DeepCopyInto(out *Function) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(Scheme)
+		**out = **in
+	}
+	in.General.DeepCopyInto(&out.General)
+	in.Input.DeepCopyInto(&out.Input)
+	in.Output.DeepCopyInto(&out.Output)
+	if in.Processing != nil {
+		in, out := &in.Processing, &out.Processing
+		*out = (*in).DeepCopy()
+	}
+	if in.Requirement != nil {
+		in, out := &in.Requirement, &out.Requirement
+		*out = new(Requirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(Configuration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(Step)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientAssistant != nil {
+		in, out := &in.ClientAssistant, &out.ClientAssistant
+		*out = new(ClientAssistant)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Assertion != nil {
+		in, out := &in.Assertion, &out.Assertion
+		*out = new(Assertion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]Event, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
+func (in *Function) DeepCopy() *Function {
+	if in == nil {
+		return nil
+	}
+	out := new(Function)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionDiscoveryRequest) DeepCopyInto(out *FunctionDiscoveryRequest) {
+	*out = *in
+	if in.Keywords != nil {
+		in, out := &in.Keywords, &out.Keywords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionDiscoveryRequest.
+func (in *FunctionDiscoveryRequest) DeepCopy() *FunctionDiscoveryRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionDiscoveryRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionDiscoveryResponse) DeepCopyInto(out *FunctionDiscoveryResponse) {
+	*out = *in
+	if in.Functions != nil {
+		in, out := &in.Functions, &out.Functions
+		*out = make([]Function, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionDiscoveryResponse.
+func (in *FunctionDiscoveryResponse) DeepCopy() *FunctionDiscoveryResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionDiscoveryResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionRestriction) DeepCopyInto(out *FunctionRestriction) {
+	*out = *in
+	if in.General != nil {
+		in, out := &in.General, &out.General
+		*out = (*in).DeepCopy()
+	}
+	if in.Processing != nil {
+		in, out := &in.Processing, &out.Processing
+		*out = (*in).DeepCopy()
+	}
+	if in.Requirements != nil {
+		in, out := &in.Requirements, &out.Requirements
+		*out = new(Requirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(Configuration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientAssistant != nil {
+		in, out := &in.ClientAssistant, &out.ClientAssistant
+		*out = new(ClientAssistant)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(Failover)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reporting != nil {
+		in, out := &in.Reporting, &out.Reporting
+		*out = (*in).DeepCopy()
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = (*in).DeepCopy()
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(Step)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = (*in).DeepCopy()
+	}
+	if in.Blacklist != nil {
+		in, out := &in.Blacklist, &out.Blacklist
+		*out = make([]Blacklist, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionRestriction.
+func (in *FunctionRestriction) DeepCopy() *FunctionRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new General.
+func (in *General) DeepCopy() *General {
+	if in == nil {
+		return nil
+	}
+	out := new(General)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareRequirement) DeepCopyInto(out *HardwareRequirement) {
+	*out = *in
+	if in.VCPU != nil {
+		in, out := &in.VCPU, &out.VCPU
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.VGPU != nil {
+		in, out := &in.VGPU, &out.VGPU
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.RAM != nil {
+		in, out := &in.RAM, &out.RAM
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(HardwareRequirementPlacement)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareRequirement.
+func (in *HardwareRequirement) DeepCopy() *HardwareRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Input) DeepCopyInto(out *Input) {
+	*out = *in
+	if in.MediaParameters != nil {
+		in, out := &in.MediaParameters, &out.MediaParameters
+		*out = make([]MediaParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataParameters != nil {
+		in, out := &in.MetadataParameters, &out.MetadataParameters
+		*out = make([]MetadataParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Input.
+func (in *Input) DeepCopy() *Input {
+	if in == nil {
+		return nil
+	}
+	out := new(Input)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancePort) DeepCopyInto(out *InstancePort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePort.
+func (in *InstancePort) DeepCopy() *InstancePort {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegerParameterValue) DeepCopyInto(out *IntegerParameterValue) {
+	*out = *in
+	in.Restrictions.DeepCopyInto(&out.Restrictions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegerParameterValue.
+func (in *IntegerParameterValue) DeepCopy() *IntegerParameterValue {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegerParameterValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegerParameterValueRestrictions) DeepCopyInto(out *IntegerParameterValueRestrictions) {
+	*out = *in
+	if in.MinValue != nil {
+		in, out := &in.MinValue, &out.MinValue
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxValue != nil {
+		in, out := &in.MaxValue, &out.MaxValue
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Increment != nil {
+		in, out := &in.Increment, &out.Increment
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegerParameterValueRestrictions.
+func (in *IntegerParameterValueRestrictions) DeepCopy() *IntegerParameterValueRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegerParameterValueRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaParameter) DeepCopyInto(out *MediaParameter) {
+	*out = *in
+	if in.Keywords != nil {
+		in, out := &in.Keywords, &out.Keywords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VideoFormat != nil {
+		in, out := &in.VideoFormat, &out.VideoFormat
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AudioFormat != nil {
+		in, out := &in.AudioFormat, &out.AudioFormat
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageFormat != nil {
+		in, out := &in.ImageFormat, &out.ImageFormat
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CodecType != nil {
+		in, out := &in.CodecType, &out.CodecType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(MediaAccessMode)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Buffersize != nil {
+		in, out := &in.Buffersize, &out.Buffersize
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.AvailabilityDuration != nil {
+		in, out := &in.AvailabilityDuration, &out.AvailabilityDuration
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.CompletionTimeout != nil {
+		in, out := &in.CompletionTimeout, &out.CompletionTimeout
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaParameter.
+func (in *MediaParameter) DeepCopy() *MediaParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaProcessingEntityCapabilities) DeepCopyInto(out *MediaProcessingEntityCapabilities) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(Scheme)
+		**out = **in
+	}
+	in.General.DeepCopyInto(&out.General)
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(Capabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]Event, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reporting != nil {
+		in, out := &in.Reporting, &out.Reporting
+		*out = (*in).DeepCopy()
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaProcessingEntityCapabilities.
+func (in *MediaProcessingEntityCapabilities) DeepCopy() *MediaProcessingEntityCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaProcessingEntityCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataParameter) DeepCopyInto(out *MetadataParameter) {
+	*out = *in
+	if in.Keywords != nil {
+		in, out := &in.Keywords, &out.Keywords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CodecType != nil {
+		in, out := &in.CodecType, &out.CodecType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(MediaAccessMode)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MinInterval != nil {
+		in, out := &in.MinInterval, &out.MinInterval
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.AvailabilityDuration != nil {
+		in, out := &in.AvailabilityDuration, &out.AvailabilityDuration
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.CachingServerURL != nil {
+		in, out := &in.CachingServerURL, &out.CachingServerURL
+		*out = new(base.URI)
+		**out = **in
+	}
+	if in.SchemeURI != nil {
+		in, out := &in.SchemeURI, &out.SchemeURI
+		*out = new(base.URI)
+		**out = **in
+	}
+	if in.CompletionTimeout != nil {
+		in, out := &in.CompletionTimeout, &out.CompletionTimeout
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataParameter.
+func (in *MetadataParameter) DeepCopy() *MetadataParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Event != nil {
+		in, out := &in.Event, &out.Event
+		*out = make([]Event, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Variable != nil {
+		in, out := &in.Variable, &out.Variable
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SystemEvents != nil {
+		in, out := &in.SystemEvents, &out.SystemEvents
+		*out = make([]RawJSON, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make(RawJSON, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+	if in.SystemVariables != nil {
+		in, out := &in.SystemVariables, &out.SystemVariables
+		*out = make([]RawJSON, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make(RawJSON, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NumberParameterValue) DeepCopyInto(out *NumberParameterValue) {
+	*out = *in
+	in.Restrictions.DeepCopyInto(&out.Restrictions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NumberParameterValue.
+func (in *NumberParameterValue) DeepCopy() *NumberParameterValue {
+	if in == nil {
+		return nil
+	}
+	out := new(NumberParameterValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NumberParameterValueRestrictions) DeepCopyInto(out *NumberParameterValueRestrictions) {
+	*out = *in
+	if in.MinValue != nil {
+		in, out := &in.MinValue, &out.MinValue
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxValue != nil {
+		in, out := &in.MaxValue, &out.MaxValue
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Increment != nil {
+		in, out := &in.Increment, &out.Increment
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NumberParameterValueRestrictions.
+func (in *NumberParameterValueRestrictions) DeepCopy() *NumberParameterValueRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(NumberParameterValueRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Output) DeepCopyInto(out *Output) {
+	*out = *in
+	if in.MediaParameters != nil {
+		in, out := &in.MediaParameters, &out.MediaParameters
+		*out = make([]MediaParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataParameters != nil {
+		in, out := &in.MetadataParameters, &out.MetadataParameters
+		*out = make([]MetadataParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Output.
+func (in *Output) DeepCopy() *Output {
+	if in == nil {
+		return nil
+	}
+	out := new(Output)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
+	if in == nil {
+		return nil
+	}
+	out := new(Parameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Port) DeepCopyInto(out *Port) {
+	*out = *in
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(PortBinding)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Port.
+func (in *Port) DeepCopy() *Port {
+	if in == nil {
+		return nil
+	}
+	out := new(Port)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortBinding) DeepCopyInto(out *PortBinding) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Keywords != nil {
+		in, out := &in.Keywords, &out.Keywords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortBinding.
+func (in *PortBinding) DeepCopy() *PortBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(PortBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Processing.
+func (in *Processing) DeepCopy() *Processing {
+	if in == nil {
+		return nil
+	}
+	out := new(Processing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessingImage) DeepCopyInto(out *ProcessingImage) {
+	*out = *in
+	if in.IsDynamic != nil {
+		in, out := &in.IsDynamic, &out.IsDynamic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StaticImageInfo != nil {
+		in, out := &in.StaticImageInfo, &out.StaticImageInfo
+		*out = new(StaticImageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamicImageInfo != nil {
+		in, out := &in.DynamicImageInfo, &out.DynamicImageInfo
+		*out = new(DynamicImageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessingImage.
+func (in *ProcessingImage) DeepCopy() *ProcessingImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessingImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RawJSON) DeepCopyInto(out *RawJSON) {
+	{
+		in := &in
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawJSON.
+func (in RawJSON) DeepCopy() RawJSON {
+	if in == nil {
+		return nil
+	}
+	out := new(RawJSON)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Reporting.
+func (in *Reporting) DeepCopy() *Reporting {
+	if in == nil {
+		return nil
+	}
+	out := new(Reporting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Repository) DeepCopyInto(out *Repository) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RepositoryMode)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]RepositoryLocation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
+func (in *Repository) DeepCopy() *Repository {
+	if in == nil {
+		return nil
+	}
+	out := new(Repository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryLocation) DeepCopyInto(out *RepositoryLocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryLocation.
+func (in *RepositoryLocation) DeepCopy() *RepositoryLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Request) DeepCopyInto(out *Request) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Request.
+func (in *Request) DeepCopy() *Request {
+	if in == nil {
+		return nil
+	}
+	out := new(Request)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Requirement) DeepCopyInto(out *Requirement) {
+	*out = *in
+	if in.Flowcontrol != nil {
+		in, out := &in.Flowcontrol, &out.Flowcontrol
+		*out = new(FlowcontrolRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(HardwareRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkflowTask != nil {
+		in, out := &in.WorkflowTask, &out.WorkflowTask
+		*out = new(WorkflowTaskRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceEstimators != nil {
+		in, out := &in.ResourceEstimators, &out.ResourceEstimators
+		*out = new(ResourceEstimatorsRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Requirement.
+func (in *Requirement) DeepCopy() *Requirement {
+	if in == nil {
+		return nil
+	}
+	out := new(Requirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAvailabilityItem) DeepCopyInto(out *ResourceAvailabilityItem) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ResourceAvailabilityItemKey)
+		**out = **in
+	}
+	if in.AbsoluteValue != nil {
+		in, out := &in.AbsoluteValue, &out.AbsoluteValue
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAvailabilityItem.
+func (in *ResourceAvailabilityItem) DeepCopy() *ResourceAvailabilityItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAvailabilityItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceEstimatorsRequirement) DeepCopyInto(out *ResourceEstimatorsRequirement) {
+	*out = *in
+	if in.DefaultValues != nil {
+		in, out := &in.DefaultValues, &out.DefaultValues
+		*out = make([]DefaultValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.ComputationalEstimator != nil {
+		in, out := &in.ComputationalEstimator, &out.ComputationalEstimator
+		*out = new(string)
+		**out = **in
+	}
+	if in.MemoryEstimator != nil {
+		in, out := &in.MemoryEstimator, &out.MemoryEstimator
+		*out = new(string)
+		**out = **in
+	}
+	if in.BandwidthEstimator != nil {
+		in, out := &in.BandwidthEstimator, &out.BandwidthEstimator
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEstimatorsRequirement.
+func (in *ResourceEstimatorsRequirement) DeepCopy() *ResourceEstimatorsRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceEstimatorsRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scale) DeepCopyInto(out *Scale) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalingType != nil {
+		in, out := &in.ScalingType, &out.ScalingType
+		*out = new(ScalingType)
+		**out = **in
+	}
+	if in.ScalingFactor != nil {
+		in, out := &in.ScalingFactor, &out.ScalingFactor
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetID != nil {
+		in, out := &in.TargetID, &out.TargetID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scale.
+func (in *Scale) DeepCopy() *Scale {
+	if in == nil {
+		return nil
+	}
+	out := new(Scale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduleType != nil {
+		in, out := &in.ScheduleType, &out.ScheduleType
+		*out = new(ScheduleType)
+		**out = **in
+	}
+	if in.ScheduleTable != nil {
+		in, out := &in.ScheduleTable, &out.ScheduleTable
+		*out = make([]ScheduleTableItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NumberOfSegments != nil {
+		in, out := &in.NumberOfSegments, &out.NumberOfSegments
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTableItem) DeepCopyInto(out *ScheduleTableItem) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Timescale != nil {
+		in, out := &in.Timescale, &out.Timescale
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTableItem.
+func (in *ScheduleTableItem) DeepCopy() *ScheduleTableItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTableItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scheme) DeepCopyInto(out *Scheme) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scheme.
+func (in *Scheme) DeepCopy() *Scheme {
+	if in == nil {
+		return nil
+	}
+	out := new(Scheme)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Security.
+func (in *Security) DeepCopy() *Security {
+	if in == nil {
+		return nil
+	}
+	out := new(Security)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityRequirement) DeepCopyInto(out *SecurityRequirement) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPsec != nil {
+		in, out := &in.IPsec, &out.IPsec
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CENC != nil {
+		in, out := &in.CENC, &out.CENC
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRequirement.
+func (in *SecurityRequirement) DeepCopy() *SecurityRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SegmentLocation) DeepCopyInto(out *SegmentLocation) {
+	*out = *in
+	if in.Scale != nil {
+		in, out := &in.Scale, &out.Scale
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+	if in.Length != nil {
+		in, out := &in.Length, &out.Length
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SegmentLocation.
+func (in *SegmentLocation) DeepCopy() *SegmentLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(SegmentLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SegmentSequence) DeepCopyInto(out *SegmentSequence) {
+	*out = *in
+	if in.Sequence != nil {
+		in, out := &in.Sequence, &out.Sequence
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SegmentSequence.
+func (in *SegmentSequence) DeepCopy() *SegmentSequence {
+	if in == nil {
+		return nil
+	}
+	out := new(SegmentSequence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupDelay) DeepCopyInto(out *StartupDelay) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupDelay.
+func (in *StartupDelay) DeepCopy() *StartupDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticImageInfo) DeepCopyInto(out *StaticImageInfo) {
+	*out = *in
+	if in.PatchURL != nil {
+		in, out := &in.PatchURL, &out.PatchURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.PatchScript != nil {
+		in, out := &in.PatchScript, &out.PatchScript
+		*out = make(RawJSON, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticImageInfo.
+func (in *StaticImageInfo) DeepCopy() *StaticImageInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticImageInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Step) DeepCopyInto(out *Step) {
+	*out = *in
+	if in.StepMode != nil {
+		in, out := &in.StepMode, &out.StepMode
+		*out = new(StepMode)
+		**out = **in
+	}
+	if in.VariableDuration != nil {
+		in, out := &in.VariableDuration, &out.VariableDuration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SegmentDuration != nil {
+		in, out := &in.SegmentDuration, &out.SegmentDuration
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.SegmentLocation != nil {
+		in, out := &in.SegmentLocation, &out.SegmentLocation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SegmentSequence != nil {
+		in, out := &in.SegmentSequence, &out.SegmentSequence
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SegmentMetadataSupportedFormats != nil {
+		in, out := &in.SegmentMetadataSupportedFormats, &out.SegmentMetadataSupportedFormats
+		*out = make([]SegmentMetadataSupportedFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperatingUnits != nil {
+		in, out := &in.OperatingUnits, &out.OperatingUnits
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TemporalOverlap != nil {
+		in, out := &in.TemporalOverlap, &out.TemporalOverlap
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.NumberOfDimensions != nil {
+		in, out := &in.NumberOfDimensions, &out.NumberOfDimensions
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.HigherDimensionSegmentDivisors != nil {
+		in, out := &in.HigherDimensionSegmentDivisors, &out.HigherDimensionSegmentDivisors
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+	if in.HigherDimensionsDescriptions != nil {
+		in, out := &in.HigherDimensionsDescriptions, &out.HigherDimensionsDescriptions
+		*out = make([]HigherDimensionsDescription, len(*in))
+		copy(*out, *in)
+	}
+	if in.HigherDimensionsSegmentOrder != nil {
+		in, out := &in.HigherDimensionsSegmentOrder, &out.HigherDimensionsSegmentOrder
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+	if in.HigherDimensionsOverlap != nil {
+		in, out := &in.HigherDimensionsOverlap, &out.HigherDimensionsOverlap
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+	if in.HigherDimensionsOperationUnits != nil {
+		in, out := &in.HigherDimensionsOperationUnits, &out.HigherDimensionsOperationUnits
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
+func (in *Step) DeepCopy() *Step {
+	if in == nil {
+		return nil
+	}
+	out := new(Step)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StringParameterValue) DeepCopyInto(out *StringParameterValue) {
+	*out = *in
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StringParameterValue.
+func (in *StringParameterValue) DeepCopy() *StringParameterValue {
+	if in == nil {
+		return nil
+	}
+	out := new(StringParameterValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Task) DeepCopyInto(out *Task) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(Scheme)
+		**out = **in
+	}
+	in.General.DeepCopyInto(&out.General)
+	in.Input.DeepCopyInto(&out.Input)
+	in.Output.DeepCopyInto(&out.Output)
+	in.Processing.DeepCopyInto(&out.Processing)
+	in.Requirement.DeepCopyInto(&out.Requirement)
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(Configuration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(Step)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupDelay != nil {
+		in, out := &in.StartupDelay, &out.StartupDelay
+		*out = new(StartupDelay)
+		**out = **in
+	}
+	if in.ClientAssistant != nil {
+		in, out := &in.ClientAssistant, &out.ClientAssistant
+		*out = new(ClientAssistant)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(Failover)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Assertion != nil {
+		in, out := &in.Assertion, &out.Assertion
+		*out = new(Assertion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reporting != nil {
+		in, out := &in.Reporting, &out.Reporting
+		*out = (*in).DeepCopy()
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = (*in).DeepCopy()
+	}
+	if in.Acknowledge != nil {
+		in, out := &in.Acknowledge, &out.Acknowledge
+		*out = new(Acknowledge)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = (*in).DeepCopy()
+	}
+	if in.Scale != nil {
+		in, out := &in.Scale, &out.Scale
+		*out = new(Scale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
+func (in *Task) DeepCopy() *Task {
+	if in == nil {
+		return nil
+	}
+	out := new(Task)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskGroupItem) DeepCopyInto(out *TaskGroupItem) {
+	*out = *in
+	if in.TaskID != nil {
+		in, out := &in.TaskID, &out.TaskID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupType != nil {
+		in, out := &in.GroupType, &out.GroupType
+		*out = new(GroupType)
+		**out = **in
+	}
+	if in.GroupMode != nil {
+		in, out := &in.GroupMode, &out.GroupMode
+		*out = new(GroupMode)
+		**out = **in
+	}
+	if in.NetZero != nil {
+		in, out := &in.NetZero, &out.NetZero
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskGroupItem.
+func (in *TaskGroupItem) DeepCopy() *TaskGroupItem {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskGroupItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskProximityEquation) DeepCopyInto(out *TaskProximityEquation) {
+	*out = *in
+	if in.DistanceParameters != nil {
+		in, out := &in.DistanceParameters, &out.DistanceParameters
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskProximityEquation.
+func (in *TaskProximityEquation) DeepCopy() *TaskProximityEquation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskProximityEquation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskProximityRequirement) DeepCopyInto(out *TaskProximityRequirement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskProximityRequirement.
+func (in *TaskProximityRequirement) DeepCopy() *TaskProximityRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskProximityRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRequest) DeepCopyInto(out *TaskRequest) {
+	*out = *in
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(Task)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRequest.
+func (in *TaskRequest) DeepCopy() *TaskRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskResponse) DeepCopyInto(out *TaskResponse) {
+	*out = *in
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(Task)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskResponse.
+func (in *TaskResponse) DeepCopy() *TaskResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskSplitEfficiency) DeepCopyInto(out *TaskSplitEfficiency) {
+	*out = *in
+	if in.SplitNorm != nil {
+		in, out := &in.SplitNorm, &out.SplitNorm
+		*out = new(TaskSplitEfficiencyNorm)
+		**out = **in
+	}
+	if in.SplitEquation != nil {
+		in, out := &in.SplitEquation, &out.SplitEquation
+		*out = new(string)
+		**out = **in
+	}
+	if in.SplitResult != nil {
+		in, out := &in.SplitResult, &out.SplitResult
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSplitEfficiency.
+func (in *TaskSplitEfficiency) DeepCopy() *TaskSplitEfficiency {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSplitEfficiency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Variable) DeepCopyInto(out *Variable) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int64)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(base.URI)
+		**out = **in
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
+func (in *Variable) DeepCopy() *Variable {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Workflow) DeepCopyInto(out *Workflow) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(Scheme)
+		**out = **in
+	}
+	in.General.DeepCopyInto(&out.General)
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Repository)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Input.DeepCopyInto(&out.Input)
+	in.Output.DeepCopyInto(&out.Output)
+	in.Processing.DeepCopyInto(&out.Processing)
+	in.Requirement.DeepCopyInto(&out.Requirement)
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(Step)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientAssistant != nil {
+		in, out := &in.ClientAssistant, &out.ClientAssistant
+		*out = new(ClientAssistant)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(Failover)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Assertion != nil {
+		in, out := &in.Assertion, &out.Assertion
+		*out = new(Assertion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reporting != nil {
+		in, out := &in.Reporting, &out.Reporting
+		*out = (*in).DeepCopy()
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = (*in).DeepCopy()
+	}
+	if in.Acknowledge != nil {
+		in, out := &in.Acknowledge, &out.Acknowledge
+		*out = new(Acknowledge)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = (*in).DeepCopy()
+	}
+	if in.Scale != nil {
+		in, out := &in.Scale, &out.Scale
+		*out = new(Scale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workflow.
+func (in *Workflow) DeepCopy() *Workflow {
+	if in == nil {
+		return nil
+	}
+	out := new(Workflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowRequest) DeepCopyInto(out *WorkflowRequest) {
+	*out = *in
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = new(Workflow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRequest.
+func (in *WorkflowRequest) DeepCopy() *WorkflowRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowResponse) DeepCopyInto(out *WorkflowResponse) {
+	*out = *in
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = new(Workflow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowResponse.
+func (in *WorkflowResponse) DeepCopy() *WorkflowResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTaskRequirement) DeepCopyInto(out *WorkflowTaskRequirement) {
+	*out = *in
+	if in.FunctionFusible != nil {
+		in, out := &in.FunctionFusible, &out.FunctionFusible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FunctionEnhancable != nil {
+		in, out := &in.FunctionEnhancable, &out.FunctionEnhancable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExecutionMode != nil {
+		in, out := &in.ExecutionMode, &out.ExecutionMode
+		*out = new(ExecutionMode)
+		**out = **in
+	}
+	if in.Proximity != nil {
+		in, out := &in.Proximity, &out.Proximity
+		*out = make([]TaskProximityRequirement, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProximityEquation != nil {
+		in, out := &in.ProximityEquation, &out.ProximityEquation
+		*out = new(TaskProximityEquation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SplitEfficiency != nil {
+		in, out := &in.SplitEfficiency, &out.SplitEfficiency
+		*out = new(TaskSplitEfficiency)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTaskRequirement.
+func (in *WorkflowTaskRequirement) DeepCopy() *WorkflowTaskRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTaskRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_0
+
+import (
+	"encoding"
+	"fmt"
+	"time"
+
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+// Granularity is the granularity of datestamps.
+type Granularity string
+
+const (
+	DayGranularity    = Granularity("YYYY-MM-DD")
+	SecondGranularity = Granularity("YYYY-MM-DDThh:mm:ssZ")
+)
+
+const (
+	dayLayout    = "2006-01-02"
+	secondLayout = "2006-01-02T15:04:05Z"
+)
+
+// Datestamp is a UTC date or date-time in the forms "YYYY-MM-DD" or "YYYY-MM-DDThh:mm:ssZ". A Datestamp without
+// Granularity is marshalled with SecondGranularity. A zero Datestamp is omitted when marshalled as XML attribute.
+type Datestamp struct {
+	time.Time
+
+	Granularity Granularity
+}
+
+var (
+	_ encoding.TextMarshaler   = &Datestamp{}
+	_ encoding.TextUnmarshaler = &Datestamp{}
+	_ xml.MarshalerAttr        = &Datestamp{}
+	_ xml.UnmarshalerAttr      = &Datestamp{}
+)
+
+// ParseDatestamp parses a datestamp in the forms "YYYY-MM-DD" or "YYYY-MM-DDThh:mm:ssZ".
+func ParseDatestamp(s string) (Datestamp, error) {
+	for _, g := range []struct {
+		layout      string
+		granularity Granularity
+	}{
+		{dayLayout, DayGranularity},
+		{secondLayout, SecondGranularity},
+	} {
+		if len(s) != len(g.layout) {
+			continue
+		}
+		t, err := time.Parse(g.layout, s)
+		if err != nil {
+			break
+		}
+		return Datestamp{Time: t, Granularity: g.granularity}, nil
+	}
+	return Datestamp{}, fmt.Errorf("ParseDatestamp: invalid datestamp %q", s)
+}
+
+// NewDatestamp returns t in UTC truncated to granularity g.
+func NewDatestamp(t time.Time, g Granularity) Datestamp {
+	t = t.UTC()
+	if g == DayGranularity {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	} else {
+		t = t.Truncate(time.Second)
+	}
+	return Datestamp{Time: t, Granularity: g}
+}
+
+func (d Datestamp) String() string {
+	if d.Granularity == DayGranularity {
+		return d.UTC().Format(dayLayout)
+	}
+	return d.UTC().Format(secondLayout)
+}
+
+func (d Datestamp) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Datestamp) UnmarshalText(text []byte) error {
+	d0, err := ParseDatestamp(string(text))
+	if err != nil {
+		return err
+	}
+	*d = d0
+	return nil
+}
+
+func (d Datestamp) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if d.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: d.String()}, nil
+}
+
+func (d *Datestamp) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// v2_0 implements the Open Archives Initiative Protocol for Metadata Harvesting (OAI-PMH) version 2.0, i.e. the
+// namespace http://www.openarchives.org/OAI/2.0/. The Dublin Core (oai_dc) and EBUCore (ebucore) metadata formats are
+// supported as record metadata.
+//
+// See https://www.openarchives.org/OAI/openarchivesprotocol.html
+// See http://www.openarchives.org/OAI/2.0/OAI-PMH.xsd
+package v2_0
@@ -0,0 +1,66 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_0
+
+// ErrorCode is the code of an OAI-PMH error or exception condition.
+type ErrorCode string
+
+const (
+	// The request includes illegal arguments, is missing required arguments, includes a repeated argument, or values
+	// for arguments have an illegal syntax.
+	BadArgumentErrorCode = ErrorCode("badArgument")
+
+	// The value of the resumptionToken argument is invalid or expired.
+	BadResumptionTokenErrorCode = ErrorCode("badResumptionToken")
+
+	// Value of the verb argument is not a legal OAI-PMH verb, the verb argument is missing, or the verb argument is
+	// repeated.
+	BadVerbErrorCode = ErrorCode("badVerb")
+
+	// The metadata format identified by the value given for the metadataPrefix argument is not supported by the item or
+	// by the repository.
+	CannotDisseminateFormatErrorCode = ErrorCode("cannotDisseminateFormat")
+
+	// The value of the identifier argument is unknown or illegal in this repository.
+	IDDoesNotExistErrorCode = ErrorCode("idDoesNotExist")
+
+	// The combination of the values of the from, until, set and metadataPrefix arguments results in an empty list.
+	NoRecordsMatchErrorCode = ErrorCode("noRecordsMatch")
+
+	// There are no metadata formats available for the specified item.
+	NoMetadataFormatsErrorCode = ErrorCode("noMetadataFormats")
+
+	// The repository does not support sets.
+	NoSetHierarchyErrorCode = ErrorCode("noSetHierarchy")
+)
+
+// Error is an OAI-PMH error or exception condition. It implements the error interface so that repository
+// implementations can return it directly.
+type Error struct {
+	Code ErrorCode `xml:"code,attr"`
+
+	// Human readable description of the error.
+	// +optional
+	Message string `xml:",chardata"`
+}
+
+func (e Error) Error() string {
+	if e.Message == "" {
+		return string(e.Code)
+	}
+	return string(e.Code) + ": " + e.Message
+}
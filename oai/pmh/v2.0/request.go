@@ -0,0 +1,217 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_0
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Request is an OAI-PMH request. In responses, it echoes the request that generated the response.
+type Request struct {
+	// +optional
+	Verb Verb `xml:"verb,attr,omitempty"`
+
+	// +optional
+	Identifier string `xml:"identifier,attr,omitempty"`
+
+	// +optional
+	MetadataPrefix string `xml:"metadataPrefix,attr,omitempty"`
+
+	// +optional
+	From Datestamp `xml:"from,attr,omitempty"`
+
+	// +optional
+	Until Datestamp `xml:"until,attr,omitempty"`
+
+	// +optional
+	Set string `xml:"set,attr,omitempty"`
+
+	// +optional
+	ResumptionToken string `xml:"resumptionToken,attr,omitempty"`
+
+	// The base URL of the repository.
+	BaseURL string `xml:",chardata"`
+}
+
+// argument requirements per verb
+type argument int
+
+const (
+	notAllowed argument = iota
+	optional
+	required
+)
+
+type verbArguments struct {
+	identifier, metadataPrefix, from, until, set argument
+
+	// resumptionToken is an exclusive argument; if given, no other arguments are allowed.
+	resumptionToken bool
+}
+
+var arguments = map[Verb]verbArguments{
+	IdentifyVerb:            {},
+	ListMetadataFormatsVerb: {identifier: optional},
+	ListSetsVerb:            {resumptionToken: true},
+	GetRecordVerb:           {identifier: required, metadataPrefix: required},
+	ListIdentifiersVerb:     {metadataPrefix: required, from: optional, until: optional, set: optional, resumptionToken: true},
+	ListRecordsVerb:         {metadataPrefix: required, from: optional, until: optional, set: optional, resumptionToken: true},
+}
+
+// ParseRequest parses the arguments of an OAI-PMH request, e.g. the query of a GET request or the form of a POST
+// request, and validates them (see Request.Validate). Errors are returned as Error with BadVerbErrorCode or
+// BadArgumentErrorCode.
+func ParseRequest(baseURL string, args url.Values) (Request, error) {
+	r := Request{BaseURL: baseURL}
+
+	if v := args["verb"]; len(v) != 1 {
+		return r, Error{Code: BadVerbErrorCode, Message: "verb argument is missing or repeated"}
+	}
+	r.Verb = Verb(args.Get("verb"))
+	if _, ok := arguments[r.Verb]; !ok {
+		return r, Error{Code: BadVerbErrorCode, Message: fmt.Sprintf("illegal verb %q", r.Verb)}
+	}
+
+	for key, vals := range args {
+		if len(vals) > 1 {
+			return r, Error{Code: BadArgumentErrorCode, Message: fmt.Sprintf("repeated argument %q", key)}
+		}
+		v := vals[0]
+		var err error
+		switch key {
+		case "verb":
+		case "identifier":
+			r.Identifier = v
+		case "metadataPrefix":
+			r.MetadataPrefix = v
+		case "from":
+			r.From, err = ParseDatestamp(v)
+		case "until":
+			r.Until, err = ParseDatestamp(v)
+		case "set":
+			r.Set = v
+		case "resumptionToken":
+			r.ResumptionToken = v
+		default:
+			return r, Error{Code: BadArgumentErrorCode, Message: fmt.Sprintf("illegal argument %q", key)}
+		}
+		if err != nil {
+			return r, Error{Code: BadArgumentErrorCode, Message: fmt.Sprintf("illegal %s datestamp %q", key, v)}
+		}
+	}
+
+	return r, r.Validate()
+}
+
+// Validate checks that r has a legal verb and that all required and no illegal arguments are given for the verb.
+// Errors are returned as Error with BadVerbErrorCode or BadArgumentErrorCode.
+func (r *Request) Validate() error {
+	a, ok := arguments[r.Verb]
+	if !ok {
+		return Error{Code: BadVerbErrorCode, Message: fmt.Sprintf("illegal verb %q", r.Verb)}
+	}
+
+	if r.ResumptionToken != "" {
+		if !a.resumptionToken {
+			return Error{Code: BadArgumentErrorCode, Message: fmt.Sprintf("%s does not support resumptionToken", r.Verb)}
+		}
+		if r.Identifier != "" || r.MetadataPrefix != "" || !r.From.IsZero() || !r.Until.IsZero() || r.Set != "" {
+			return Error{Code: BadArgumentErrorCode, Message: "resumptionToken is an exclusive argument"}
+		}
+		return nil
+	}
+
+	for _, arg := range []struct {
+		name  string
+		given bool
+		a     argument
+	}{
+		{"identifier", r.Identifier != "", a.identifier},
+		{"metadataPrefix", r.MetadataPrefix != "", a.metadataPrefix},
+		{"from", !r.From.IsZero(), a.from},
+		{"until", !r.Until.IsZero(), a.until},
+		{"set", r.Set != "", a.set},
+	} {
+		switch {
+		case arg.given && arg.a == notAllowed:
+			return Error{Code: BadArgumentErrorCode, Message: fmt.Sprintf("illegal argument %q for %s", arg.name, r.Verb)}
+		case !arg.given && arg.a == required:
+			return Error{Code: BadArgumentErrorCode, Message: fmt.Sprintf("missing argument %q for %s", arg.name, r.Verb)}
+		}
+	}
+
+	if !r.From.IsZero() && !r.Until.IsZero() {
+		if r.From.Granularity != r.Until.Granularity {
+			return Error{Code: BadArgumentErrorCode, Message: "from and until have different granularities"}
+		}
+		if r.From.After(r.Until.Time) {
+			return Error{Code: BadArgumentErrorCode, Message: "from is later than until"}
+		}
+	}
+	return nil
+}
+
+// Query returns the arguments of r as URL query.
+func (r *Request) Query() url.Values {
+	q := url.Values{}
+	set := func(key, val string) {
+		if val != "" {
+			q.Set(key, val)
+		}
+	}
+	set("verb", string(r.Verb))
+	set("identifier", r.Identifier)
+	set("metadataPrefix", r.MetadataPrefix)
+	if !r.From.IsZero() {
+		set("from", r.From.String())
+	}
+	if !r.Until.IsZero() {
+		set("until", r.Until.String())
+	}
+	set("set", r.Set)
+	set("resumptionToken", r.ResumptionToken)
+	return q
+}
+
+// URL returns the URL of a GET request for r.
+func (r *Request) URL() (*url.URL, error) {
+	u, err := url.Parse(r.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("Request.URL: %w", err)
+	}
+	u.RawQuery = r.Query().Encode()
+	return u, nil
+}
+
+// NewResponse returns a response to req with the given response date. If errs contain BadVerbErrorCode or
+// BadArgumentErrorCode, the request is echoed without arguments as required by the protocol.
+func NewResponse(req Request, responseDate time.Time, errs ...Error) *Response {
+	for _, e := range errs {
+		if e.Code == BadVerbErrorCode || e.Code == BadArgumentErrorCode {
+			req = Request{BaseURL: req.BaseURL}
+			break
+		}
+	}
+	return &Response{
+		SchemaLocation: SchemaLocation,
+		ResponseDate:   NewDatestamp(responseDate, SecondGranularity),
+		Request:        req,
+		Errors:         errs,
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_0
+
+import (
+	"github.com/nagare-media/models.go/dcmi/dc"
+	ebucore "github.com/nagare-media/models.go/ebu/ebucore/v1.10"
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+const (
+	ProtocolVersion = "2.0"
+
+	XMLNS          = "http://www.openarchives.org/OAI/2.0/"
+	SchemaLocation = XMLNS + " http://www.openarchives.org/OAI/2.0/OAI-PMH.xsd"
+
+	OAIDCXMLNS          = "http://www.openarchives.org/OAI/2.0/oai_dc/"
+	OAIDCXMLNSPrefix    = "oai_dc"
+	OAIDCSchema         = "http://www.openarchives.org/OAI/2.0/oai_dc.xsd"
+	OAIDCSchemaLocation = OAIDCXMLNS + " " + OAIDCSchema
+)
+
+// Metadata prefixes of the supported metadata formats.
+const (
+	OAIDCMetadataPrefix   = "oai_dc"
+	EBUCoreMetadataPrefix = "ebucore"
+)
+
+var (
+	// OAIDCMetadataFormat is the metadata format of unqualified Dublin Core records. Repositories must support it.
+	OAIDCMetadataFormat = MetadataFormat{
+		MetadataPrefix:    OAIDCMetadataPrefix,
+		Schema:            OAIDCSchema,
+		MetadataNamespace: OAIDCXMLNS,
+	}
+
+	// EBUCoreMetadataFormat is the metadata format of EBUCore records.
+	EBUCoreMetadataFormat = MetadataFormat{
+		MetadataPrefix:    EBUCoreMetadataPrefix,
+		Schema:            "http://www.ebu.ch/metadata/schemas/EBUCore/20200805/ebucore.xsd",
+		MetadataNamespace: ebucore.XMLNS,
+	}
+)
+
+// Verb is the OAI-PMH request type.
+type Verb string
+
+const (
+	IdentifyVerb            = Verb("Identify")
+	ListMetadataFormatsVerb = Verb("ListMetadataFormats")
+	ListSetsVerb            = Verb("ListSets")
+	GetRecordVerb           = Verb("GetRecord")
+	ListIdentifiersVerb     = Verb("ListIdentifiers")
+	ListRecordsVerb         = Verb("ListRecords")
+)
+
+// Response is the root element of all OAI-PMH responses. Depending on the verb of the request, exactly one of the verb
+// elements is set unless the response contains errors.
+type Response struct {
+	XMLName xml.Name `xml:"http://www.openarchives.org/OAI/2.0/ OAI-PMH"`
+
+	SchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance xsi:schemaLocation,attr,omitempty"`
+
+	// Time and date of the response in UTC.
+	ResponseDate Datestamp `xml:"http://www.openarchives.org/OAI/2.0/ responseDate"`
+
+	// The request that generated the response.
+	Request Request `xml:"http://www.openarchives.org/OAI/2.0/ request"`
+
+	// Errors or exceptions that occurred while processing the request.
+	// +optional
+	Errors []Error `xml:"http://www.openarchives.org/OAI/2.0/ error,omitempty"`
+
+	// +optional
+	Identify *Identify `xml:"http://www.openarchives.org/OAI/2.0/ Identify,omitempty"`
+
+	// +optional
+	ListMetadataFormats *ListMetadataFormats `xml:"http://www.openarchives.org/OAI/2.0/ ListMetadataFormats,omitempty"`
+
+	// +optional
+	ListSets *ListSets `xml:"http://www.openarchives.org/OAI/2.0/ ListSets,omitempty"`
+
+	// +optional
+	GetRecord *GetRecord `xml:"http://www.openarchives.org/OAI/2.0/ GetRecord,omitempty"`
+
+	// +optional
+	ListIdentifiers *ListIdentifiers `xml:"http://www.openarchives.org/OAI/2.0/ ListIdentifiers,omitempty"`
+
+	// +optional
+	ListRecords *ListRecords `xml:"http://www.openarchives.org/OAI/2.0/ ListRecords,omitempty"`
+}
+
+// Identify is used to retrieve information about a repository.
+type Identify struct {
+	// Human readable name for the repository.
+	RepositoryName string `xml:"http://www.openarchives.org/OAI/2.0/ repositoryName"`
+
+	// The base URL of the repository.
+	BaseURL string `xml:"http://www.openarchives.org/OAI/2.0/ baseURL"`
+
+	// The version of the OAI-PMH supported by the repository.
+	ProtocolVersion string `xml:"http://www.openarchives.org/OAI/2.0/ protocolVersion"`
+
+	// The e-mail addresses of the administrators of the repository.
+	AdminEmail []string `xml:"http://www.openarchives.org/OAI/2.0/ adminEmail"`
+
+	// A lower limit for all datestamps recorded in the repository.
+	EarliestDatestamp Datestamp `xml:"http://www.openarchives.org/OAI/2.0/ earliestDatestamp"`
+
+	// The manner in which the repository supports the notion of deleted records.
+	DeletedRecord DeletedRecord `xml:"http://www.openarchives.org/OAI/2.0/ deletedRecord"`
+
+	// The finest harvesting granularity supported by the repository.
+	Granularity Granularity `xml:"http://www.openarchives.org/OAI/2.0/ granularity"`
+
+	// Compression encodings supported by the repository, e.g. "gzip" or "deflate".
+	// +optional
+	Compression []string `xml:"http://www.openarchives.org/OAI/2.0/ compression,omitempty"`
+
+	// Extensible mechanism for communities to describe their repositories.
+	// +optional
+	Description []Any `xml:"http://www.openarchives.org/OAI/2.0/ description,omitempty"`
+}
+
+// DeletedRecord is the manner in which a repository supports the notion of deleted records.
+type DeletedRecord string
+
+const (
+	// The repository does not maintain information about deletions.
+	NoDeletedRecord = DeletedRecord("no")
+
+	// The repository does not guarantee that a list of deletions is maintained persistently or consistently.
+	TransientDeletedRecord = DeletedRecord("transient")
+
+	// The repository maintains information about deletions with no time limit.
+	PersistentDeletedRecord = DeletedRecord("persistent")
+)
+
+// ListMetadataFormats is used to retrieve the metadata formats available from a repository.
+type ListMetadataFormats struct {
+	MetadataFormats []MetadataFormat `xml:"http://www.openarchives.org/OAI/2.0/ metadataFormat"`
+}
+
+type MetadataFormat struct {
+	MetadataPrefix    string `xml:"http://www.openarchives.org/OAI/2.0/ metadataPrefix"`
+	Schema            string `xml:"http://www.openarchives.org/OAI/2.0/ schema"`
+	MetadataNamespace string `xml:"http://www.openarchives.org/OAI/2.0/ metadataNamespace"`
+}
+
+// ListSets is used to retrieve the set structure of a repository.
+type ListSets struct {
+	Sets []Set `xml:"http://www.openarchives.org/OAI/2.0/ set"`
+
+	// +optional
+	ResumptionToken *ResumptionToken `xml:"http://www.openarchives.org/OAI/2.0/ resumptionToken,omitempty"`
+}
+
+type Set struct {
+	// Colon separated list indicating the path from the root of the set hierarchy to the set, e.g. "music:(muzak)".
+	SetSpec string `xml:"http://www.openarchives.org/OAI/2.0/ setSpec"`
+
+	// Human readable name of the set.
+	SetName string `xml:"http://www.openarchives.org/OAI/2.0/ setName"`
+
+	// +optional
+	SetDescription []Any `xml:"http://www.openarchives.org/OAI/2.0/ setDescription,omitempty"`
+}
+
+// GetRecord is used to retrieve an individual metadata record from a repository.
+type GetRecord struct {
+	Record Record `xml:"http://www.openarchives.org/OAI/2.0/ record"`
+}
+
+// ListIdentifiers is an abbreviated form of ListRecords, retrieving only headers rather than records.
+type ListIdentifiers struct {
+	Headers []Header `xml:"http://www.openarchives.org/OAI/2.0/ header"`
+
+	// +optional
+	ResumptionToken *ResumptionToken `xml:"http://www.openarchives.org/OAI/2.0/ resumptionToken,omitempty"`
+}
+
+// ListRecords is used to harvest records from a repository.
+type ListRecords struct {
+	Records []Record `xml:"http://www.openarchives.org/OAI/2.0/ record"`
+
+	// +optional
+	ResumptionToken *ResumptionToken `xml:"http://www.openarchives.org/OAI/2.0/ resumptionToken,omitempty"`
+}
+
+// Record is the metadata of a single item in a single metadata format.
+type Record struct {
+	Header Header `xml:"http://www.openarchives.org/OAI/2.0/ header"`
+
+	// Metadata of the record. Deleted records have no metadata.
+	// +optional
+	Metadata *Metadata `xml:"http://www.openarchives.org/OAI/2.0/ metadata,omitempty"`
+
+	// Information about the metadata, e.g. rights or provenance statements.
+	// +optional
+	About []Any `xml:"http://www.openarchives.org/OAI/2.0/ about,omitempty"`
+}
+
+// Header contains the unique identifier of the item and properties necessary for selective harvesting.
+type Header struct {
+	// Status of the record. Only StatusDeleted is defined.
+	// +optional
+	Status Status `xml:"status,attr,omitempty"`
+
+	// Unique identifier of the item in the repository.
+	Identifier string `xml:"http://www.openarchives.org/OAI/2.0/ identifier"`
+
+	// Date of creation, modification or deletion of the record.
+	Datestamp Datestamp `xml:"http://www.openarchives.org/OAI/2.0/ datestamp"`
+
+	// Set membership of the item.
+	// +optional
+	SetSpec []string `xml:"http://www.openarchives.org/OAI/2.0/ setSpec,omitempty"`
+}
+
+type Status string
+
+const (
+	DeletedStatus = Status("deleted")
+)
+
+// Metadata is the metadata of a record. Exactly one field is set.
+type Metadata struct {
+	// +optional
+	DC *DC `xml:"http://www.openarchives.org/OAI/2.0/oai_dc/ oai_dc:dc,omitempty"`
+
+	// +optional
+	EBUCore *ebucore.Main `xml:"urn:ebu:metadata-schema:ebucore ebucore:ebuCoreMain,omitempty"`
+
+	// Metadata in other formats.
+	// +optional
+	Other *Any `xml:",any,omitempty"`
+}
+
+// DC is the Dublin Core metadata format oai_dc.
+type DC struct {
+	XMLName xml.Name `xml:"http://www.openarchives.org/OAI/2.0/oai_dc/ oai_dc:dc"`
+
+	SchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance xsi:schemaLocation,attr,omitempty"`
+
+	dc.Elements
+}
+
+// ResumptionToken is used to continue incomplete list responses.
+type ResumptionToken struct {
+	// The token. It is empty in the response containing the last part of the list.
+	Value string `xml:",chardata"`
+
+	// The time the token expires.
+	// +optional
+	ExpirationDate Datestamp `xml:"expirationDate,attr,omitempty"`
+
+	// Number of elements of the complete list.
+	// +optional
+	CompleteListSize *int `xml:"completeListSize,attr,omitempty"`
+
+	// Number of elements of the complete list already returned in previous responses, i.e. the index of the first
+	// element of this response.
+	// +optional
+	Cursor *int `xml:"cursor,attr,omitempty"`
+}
+
+// Any is an XML element in an arbitrary namespace, e.g. repository descriptions or metadata in unsupported formats.
+type Any struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",innerxml"`
+}
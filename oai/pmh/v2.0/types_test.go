@@ -0,0 +1,273 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2_0_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nagare-media/models.go/dcmi/dc"
+	ebucore "github.com/nagare-media/models.go/ebu/ebucore/v1.10"
+	oaipmh "github.com/nagare-media/models.go/oai/pmh/v2.0"
+	"github.com/nagare-media/models.go/third_party/encoding/xml"
+)
+
+const baseURL = "https://archive.example.com/oai"
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func day(y int, m time.Month, d int) oaipmh.Datestamp {
+	return oaipmh.Datestamp{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Granularity: oaipmh.DayGranularity}
+}
+
+func second(y int, m time.Month, d, h int) oaipmh.Datestamp {
+	return oaipmh.Datestamp{Time: time.Date(y, m, d, h, 0, 0, 0, time.UTC), Granularity: oaipmh.SecondGranularity}
+}
+
+var (
+	responseDate = second(2025, 3, 1, 12)
+
+	fixtures = map[string]*oaipmh.Response{
+		"testdata/fixture_identify.xml": {
+			SchemaLocation: oaipmh.SchemaLocation,
+			ResponseDate:   responseDate,
+			Request:        oaipmh.Request{Verb: oaipmh.IdentifyVerb, BaseURL: baseURL},
+			Identify: &oaipmh.Identify{
+				RepositoryName:    "nagare media archive",
+				BaseURL:           baseURL,
+				ProtocolVersion:   oaipmh.ProtocolVersion,
+				AdminEmail:        []string{"archive@example.com"},
+				EarliestDatestamp: second(2021, 1, 1, 0),
+				DeletedRecord:     oaipmh.PersistentDeletedRecord,
+				Granularity:       oaipmh.SecondGranularity,
+				Compression:       []string{"gzip"},
+				Description: []oaipmh.Any{{
+					XMLName: xml.Name{Space: oaipmh.XMLNS, Local: "description"},
+					Content: `<oai-identifier xmlns="http://www.openarchives.org/OAI/2.0/oai-identifier">` +
+						`<scheme>oai</scheme><repositoryIdentifier>archive.example.com</repositoryIdentifier>` +
+						`<delimiter>:</delimiter><sampleIdentifier>oai:archive.example.com:media-1</sampleIdentifier>` +
+						`</oai-identifier>`,
+				}},
+			},
+		},
+
+		"testdata/fixture_listrecords_oai_dc.xml": {
+			SchemaLocation: oaipmh.SchemaLocation,
+			ResponseDate:   responseDate,
+			Request: oaipmh.Request{
+				Verb:           oaipmh.ListRecordsVerb,
+				MetadataPrefix: oaipmh.OAIDCMetadataPrefix,
+				From:           day(2024, 1, 1),
+				Set:            "video",
+				BaseURL:        baseURL,
+			},
+			ListRecords: &oaipmh.ListRecords{
+				Records: []oaipmh.Record{
+					{
+						Header: oaipmh.Header{
+							Identifier: "oai:archive.example.com:media-1",
+							Datestamp:  day(2024, 5, 3),
+							SetSpec:    []string{"video", "video:lectures"},
+						},
+						Metadata: &oaipmh.Metadata{
+							DC: &oaipmh.DC{
+								SchemaLocation: oaipmh.OAIDCSchemaLocation,
+								Elements: dc.Elements{
+									Title:      []dc.Title{{Value: "Introduction to Media Processing", XMLLang: "en"}},
+									Creator:    []dc.Creator{{Value: "Doe, John"}},
+									Date:       []dc.Date{{Value: "2024-05-02"}},
+									Type:       []dc.Type{{Value: "MovingImage"}},
+									Identifier: []dc.Identifier{{Value: "https://archive.example.com/media/1"}},
+								},
+							},
+						},
+					},
+					{
+						Header: oaipmh.Header{
+							Status:     oaipmh.DeletedStatus,
+							Identifier: "oai:archive.example.com:media-2",
+							Datestamp:  day(2024, 6, 10),
+							SetSpec:    []string{"video"},
+						},
+					},
+				},
+				ResumptionToken: &oaipmh.ResumptionToken{
+					Value:            "video-2024-01-01-2",
+					ExpirationDate:   second(2025, 3, 2, 12),
+					CompleteListSize: ptr(5),
+					Cursor:           ptr(0),
+				},
+			},
+		},
+
+		"testdata/fixture_getrecord_ebucore.xml": {
+			SchemaLocation: oaipmh.SchemaLocation,
+			ResponseDate:   responseDate,
+			Request: oaipmh.Request{
+				Verb:           oaipmh.GetRecordVerb,
+				Identifier:     "oai:archive.example.com:media-1",
+				MetadataPrefix: oaipmh.EBUCoreMetadataPrefix,
+				BaseURL:        baseURL,
+			},
+			GetRecord: &oaipmh.GetRecord{
+				Record: oaipmh.Record{
+					Header: oaipmh.Header{
+						Identifier: "oai:archive.example.com:media-1",
+						Datestamp:  day(2024, 5, 3),
+					},
+					Metadata: &oaipmh.Metadata{
+						EBUCore: &ebucore.Main{
+							Version: "1.10",
+							CoreMetadata: ebucore.CoreMetadata{
+								Title: []ebucore.Title{{
+									DCTitle: []dc.Title{{Value: "Introduction to Media Processing", XMLLang: "en"}},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"testdata/fixture_listidentifiers.xml": {
+			SchemaLocation: oaipmh.SchemaLocation,
+			ResponseDate:   responseDate,
+			Request: oaipmh.Request{
+				Verb:            oaipmh.ListIdentifiersVerb,
+				ResumptionToken: "video-2024-01-01-2",
+				BaseURL:         baseURL,
+			},
+			ListIdentifiers: &oaipmh.ListIdentifiers{
+				Headers: []oaipmh.Header{{
+					Identifier: "oai:archive.example.com:media-3",
+					Datestamp:  day(2024, 7, 1),
+					SetSpec:    []string{"video"},
+				}},
+				ResumptionToken: &oaipmh.ResumptionToken{
+					CompleteListSize: ptr(5),
+					Cursor:           ptr(4),
+				},
+			},
+		},
+
+		"testdata/fixture_error.xml": oaipmh.NewResponse(
+			oaipmh.Request{Verb: oaipmh.ListSetsVerb, BaseURL: baseURL},
+			responseDate.Time,
+			oaipmh.Error{Code: oaipmh.BadArgumentErrorCode, Message: `illegal argument "foo"`},
+		),
+	}
+
+	cmpOpts = []cmp.Option{
+		cmpopts.IgnoreFields(oaipmh.Response{}, "XMLName"),
+		cmpopts.IgnoreFields(oaipmh.DC{}, "XMLName"),
+		cmpopts.IgnoreFields(ebucore.Main{}, "XMLName"),
+	}
+)
+
+func TestXMLRoundTrip(t *testing.T) {
+	for file, want := range fixtures {
+		str, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("%s: reading file failed", file)
+		}
+
+		got := &oaipmh.Response{}
+		if err := xml.Unmarshal(str, got); err != nil {
+			t.Fatalf("%s: unmarshal failed: %s", file, err)
+		}
+		if diff := cmp.Diff(want, got, cmpOpts...); diff != "" {
+			t.Errorf("%s: unexpected value (-want +got):\n%s", file, diff)
+		}
+
+		str, err = xml.MarshalIndent(got, "", "  ")
+		if err != nil {
+			t.Fatalf("%s: marshal failed: %s", file, err)
+		}
+		again := &oaipmh.Response{}
+		if err := xml.Unmarshal(str, again); err != nil {
+			t.Fatalf("%s: unmarshal of marshalled value failed: %s\n%s", file, err, str)
+		}
+		if diff := cmp.Diff(got, again, cmpOpts...); diff != "" {
+			t.Errorf("%s: round trip mismatch (-want +got):\n%s", file, diff)
+		}
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    oaipmh.Request
+		errCode oaipmh.ErrorCode
+	}{
+		{"verb=Identify", oaipmh.Request{Verb: oaipmh.IdentifyVerb, BaseURL: baseURL}, ""},
+		{"verb=ListRecords&metadataPrefix=oai_dc&from=2024-01-01&until=2024-12-31&set=video", oaipmh.Request{
+			Verb:           oaipmh.ListRecordsVerb,
+			MetadataPrefix: oaipmh.OAIDCMetadataPrefix,
+			From:           day(2024, 1, 1),
+			Until:          day(2024, 12, 31),
+			Set:            "video",
+			BaseURL:        baseURL,
+		}, ""},
+		{"verb=ListIdentifiers&resumptionToken=abc", oaipmh.Request{
+			Verb:            oaipmh.ListIdentifiersVerb,
+			ResumptionToken: "abc",
+			BaseURL:         baseURL,
+		}, ""},
+		{"", oaipmh.Request{}, oaipmh.BadVerbErrorCode},
+		{"verb=Identify&verb=Identify", oaipmh.Request{}, oaipmh.BadVerbErrorCode},
+		{"verb=Harvest", oaipmh.Request{}, oaipmh.BadVerbErrorCode},
+		{"verb=Identify&identifier=x", oaipmh.Request{}, oaipmh.BadArgumentErrorCode},
+		{"verb=GetRecord&identifier=x", oaipmh.Request{}, oaipmh.BadArgumentErrorCode},
+		{"verb=ListRecords&metadataPrefix=oai_dc&foo=bar", oaipmh.Request{}, oaipmh.BadArgumentErrorCode},
+		{"verb=ListRecords&metadataPrefix=oai_dc&from=yesterday", oaipmh.Request{}, oaipmh.BadArgumentErrorCode},
+		{"verb=ListRecords&metadataPrefix=oai_dc&from=2024-01-01&until=2024-12-31T00:00:00Z", oaipmh.Request{},
+			oaipmh.BadArgumentErrorCode},
+		{"verb=ListRecords&metadataPrefix=oai_dc&from=2024-12-31&until=2024-01-01", oaipmh.Request{},
+			oaipmh.BadArgumentErrorCode},
+		{"verb=ListRecords&metadataPrefix=oai_dc&resumptionToken=abc", oaipmh.Request{}, oaipmh.BadArgumentErrorCode},
+	}
+
+	for _, tc := range tests {
+		q, _ := url.ParseQuery(tc.query)
+		got, err := oaipmh.ParseRequest(baseURL, q)
+		if tc.errCode != "" {
+			var oaiErr oaipmh.Error
+			if !errors.As(err, &oaiErr) || oaiErr.Code != tc.errCode {
+				t.Errorf("%q: expected %s error, got %v", tc.query, tc.errCode, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", tc.query, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("%q: mismatch (-want +got):\n%s", tc.query, diff)
+		}
+		if q2 := got.Query(); q2.Encode() != q.Encode() {
+			t.Errorf("%q: Query returned %q", tc.query, q2.Encode())
+		}
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opencast
+
+import (
+	"slices"
+
+	"github.com/nagare-media/models.go/internal/deepcopy"
+)
+
+// Hand-written because the generator cannot copy the *time.Time field of MediaPackage.
+
+// DeepCopyInto is a manually written deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaPackage) DeepCopyInto(out *MediaPackage) {
+	*out = *in
+	if in.Start != nil {
+		start := *in.Start
+		out.Start = &start
+	}
+	out.Creators = slices.Clone(in.Creators)
+	out.Contributors = slices.Clone(in.Contributors)
+	out.Subjects = slices.Clone(in.Subjects)
+	out.Media = deepcopy.Slice(in.Media)
+	out.Metadata = deepcopy.Slice(in.Metadata)
+	out.Attachments = deepcopy.Slice(in.Attachments)
+	out.Publications = deepcopy.Slice(in.Publications)
+}
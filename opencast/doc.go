@@ -15,4 +15,6 @@ limitations under the License.
 */
 
 // opencast implements types used in Opencast (https://opencast.org/).
+//
+// +kubebuilder:object:generate=true
 package opencast
@@ -19,6 +19,7 @@ package opencast
 import (
 	"time"
 
+	"github.com/nagare-media/models.go/base"
 	"github.com/nagare-media/models.go/third_party/encoding/xml"
 )
 
@@ -40,7 +41,7 @@ type MediaPackage struct {
 	SeriesTitle string `xml:"http://mediapackage.opencastproject.org seriestitle,omitempty"`
 
 	// Deprecated: This is not guaranteed to be correct. Use the metadata contained in the Dublin Core catalog instead.
-	Language string `xml:"http://mediapackage.opencastproject.org language,omitempty"`
+	Language base.LanguageTag `xml:"http://mediapackage.opencastproject.org language,omitempty"`
 
 	Series string `xml:"http://mediapackage.opencastproject.org series,omitempty"`
 
@@ -189,3 +189,22 @@ func TestMarshalMediaPackage(t *testing.T) {
 	// TODO: check output
 	_ = str
 }
+
+func TestDeepCopyMediaPackage(t *testing.T) {
+	got := want.DeepCopy()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("copy differs (-want +got):\n%s", diff)
+	}
+
+	*got.Start = got.Start.Add(time.Hour)
+	got.Creators[0] = "Jane Doe"
+	got.Attachments[0].Tags[0] = "changed"
+	got.Attachments[0].Reference.Properties["example-for"] = "changed"
+	got.Attachments[0].Properties["imageCount"] = "0"
+
+	if !want.Start.Equal(start) || want.Creators[0] == "Jane Doe" || want.Attachments[0].Tags[0] == "changed" ||
+		want.Attachments[0].Reference.Properties["example-for"] == "changed" ||
+		want.Attachments[0].Properties["imageCount"] == "0" {
+		t.Error("modifying the copy modified the original")
+	}
+}
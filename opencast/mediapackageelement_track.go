@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/nagare-media/models.go/base"
 )
 
 type Track struct {
@@ -78,6 +80,16 @@ type VideoStream struct {
 	ScanType   *Scan       `xml:"http://mediapackage.opencastproject.org scantype,omitempty"`
 }
 
+// FrameRateRational returns the frame rate as rational. Well-known rates like 29.97 are snapped to their exact value.
+func (vs *VideoStream) FrameRateRational() (base.Rational, error) {
+	return base.RationalFromFloat(float64(vs.FrameRate))
+}
+
+// SetFrameRateRational sets the frame rate to the nearest float32 value of r.
+func (vs *VideoStream) SetFrameRateRational(r base.Rational) {
+	vs.FrameRate = float32(r.Float64())
+}
+
 type Resolution struct {
 	Width  int `xml:"http://mediapackage.opencastproject.org width,omitempty"`
 	Height int `xml:"http://mediapackage.opencastproject.org hight,omitempty"`
@@ -0,0 +1,365 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2021-2025 The nagare media authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package opencast
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Attachment) DeepCopyInto(out *Attachment) {
+	*out = *in
+	in.MediaPackageElement.DeepCopyInto(&out.MediaPackageElement)
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(Properties, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Attachment.
+func (in *Attachment) DeepCopy() *Attachment {
+	if in == nil {
+		return nil
+	}
+	out := new(Attachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AudioStream) DeepCopyInto(out *AudioStream) {
+	*out = *in
+	out.Stream = in.Stream
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioStream.
+func (in *AudioStream) DeepCopy() *AudioStream {
+	if in == nil {
+		return nil
+	}
+	out := new(AudioStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Catalog) DeepCopyInto(out *Catalog) {
+	*out = *in
+	in.MediaPackageElement.DeepCopyInto(&out.MediaPackageElement)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Catalog.
+func (in *Catalog) DeepCopy() *Catalog {
+	if in == nil {
+		return nil
+	}
+	out := new(Catalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Checksum) DeepCopyInto(out *Checksum) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Checksum.
+func (in *Checksum) DeepCopy() *Checksum {
+	if in == nil {
+		return nil
+	}
+	out := new(Checksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Device) DeepCopyInto(out *Device) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Device.
+func (in *Device) DeepCopy() *Device {
+	if in == nil {
+		return nil
+	}
+	out := new(Device)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Encoder) DeepCopyInto(out *Encoder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Encoder.
+func (in *Encoder) DeepCopy() *Encoder {
+	if in == nil {
+		return nil
+	}
+	out := new(Encoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaPackage.
+func (in *MediaPackage) DeepCopy() *MediaPackage {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaPackage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaPackageElement) DeepCopyInto(out *MediaPackageElement) {
+	*out = *in
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(MediaPackageReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(Checksum)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaPackageElement.
+func (in *MediaPackageElement) DeepCopy() *MediaPackageElement {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaPackageElement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaPackageReference) DeepCopyInto(out *MediaPackageReference) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaPackageReference.
+func (in *MediaPackageReference) DeepCopy() *MediaPackageReference {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaPackageReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Properties) DeepCopyInto(out *Properties) {
+	{
+		in := &in
+		*out = make(Properties, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Properties.
+func (in Properties) DeepCopy() Properties {
+	if in == nil {
+		return nil
+	}
+	out := new(Properties)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Publication) DeepCopyInto(out *Publication) {
+	*out = *in
+	in.MediaPackageElement.DeepCopyInto(&out.MediaPackageElement)
+	if in.Media != nil {
+		in, out := &in.Media, &out.Media
+		*out = make([]Track, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]Catalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]Attachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Publication.
+func (in *Publication) DeepCopy() *Publication {
+	if in == nil {
+		return nil
+	}
+	out := new(Publication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Resolution) DeepCopyInto(out *Resolution) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resolution.
+func (in *Resolution) DeepCopy() *Resolution {
+	if in == nil {
+		return nil
+	}
+	out := new(Resolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scan) DeepCopyInto(out *Scan) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scan.
+func (in *Scan) DeepCopy() *Scan {
+	if in == nil {
+		return nil
+	}
+	out := new(Scan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Stream) DeepCopyInto(out *Stream) {
+	*out = *in
+	out.Device = in.Device
+	out.Encoder = in.Encoder
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stream.
+func (in *Stream) DeepCopy() *Stream {
+	if in == nil {
+		return nil
+	}
+	out := new(Stream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubtitleStream) DeepCopyInto(out *SubtitleStream) {
+	*out = *in
+	out.Stream = in.Stream
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubtitleStream.
+func (in *SubtitleStream) DeepCopy() *SubtitleStream {
+	if in == nil {
+		return nil
+	}
+	out := new(SubtitleStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Track) DeepCopyInto(out *Track) {
+	*out = *in
+	in.MediaPackageElement.DeepCopyInto(&out.MediaPackageElement)
+	if in.Audio != nil {
+		in, out := &in.Audio, &out.Audio
+		*out = make([]AudioStream, len(*in))
+		copy(*out, *in)
+	}
+	if in.Video != nil {
+		in, out := &in.Video, &out.Video
+		*out = make([]VideoStream, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Subtitle != nil {
+		in, out := &in.Subtitle, &out.Subtitle
+		*out = make([]SubtitleStream, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Track.
+func (in *Track) DeepCopy() *Track {
+	if in == nil {
+		return nil
+	}
+	out := new(Track)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoStream) DeepCopyInto(out *VideoStream) {
+	*out = *in
+	out.Stream = in.Stream
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(Resolution)
+		**out = **in
+	}
+	if in.ScanType != nil {
+		in, out := &in.ScanType, &out.ScanType
+		*out = new(Scan)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoStream.
+func (in *VideoStream) DeepCopy() *VideoStream {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoStream)
+	in.DeepCopyInto(out)
+	return out
+}